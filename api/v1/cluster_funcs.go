@@ -21,6 +21,7 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"regexp"
@@ -368,26 +369,85 @@ func (target *RecoveryTarget) GetTargetTLI() string {
 	return target.TargetTLI
 }
 
-// GetSizeOrNil returns the requests storage size
+// ShouldResizeInUseVolumes is true when we should resize the PVCs
+// using this storage configuration that we already created
+func (s *StorageConfiguration) ShouldResizeInUseVolumes() bool {
+	if s == nil || s.ResizeInUseVolumes == nil {
+		return true
+	}
+
+	return *s.ResizeInUseVolumes
+}
+
+// ParseStorageSize parses a storage size, checking that it's
+// a valid storage quantity, and returns it in its canonical form
+func ParseStorageSize(value string) (resource.Quantity, error) {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("invalid storage size %q: %w", value, err)
+	}
+
+	normalized, err := NormalizeStorageQuantity(quantity)
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("invalid storage size %q: %w", value, err)
+	}
+
+	return normalized, nil
+}
+
+// NormalizeStorageQuantity checks that a quantity can be used as a
+// storage size, and converts it to binary SI. This canonical form is meant
+// to compare and display sizes expressed with different units, like 1G and
+// 1024Mi, e.g. 1000000000 and 1Gi. The sizes applied to the PVCs keep the
+// units chosen by the user instead, as some CSI drivers and cloud quotas
+// work with decimal units
+func NormalizeStorageQuantity(quantity resource.Quantity) (resource.Quantity, error) {
+	if err := ValidateStorageQuantity(quantity); err != nil {
+		return resource.Quantity{}, err
+	}
+
+	return *resource.NewQuantity(quantity.Value(), resource.BinarySI), nil
+}
+
+// ValidateStorageQuantity checks that a quantity can be used as a
+// storage size, i.e. that it's a positive and whole number of bytes.
+// The returned error doesn't include the quantity, which is up to the caller
+func ValidateStorageQuantity(quantity resource.Quantity) error {
+	if quantity.Sign() <= 0 {
+		return errors.New("must be greater than zero")
+	}
+
+	if rounded := quantity.DeepCopy(); !rounded.RoundUp(0) {
+		return errors.New("must be a whole number of bytes")
+	}
+
+	return nil
+}
+
+// GetSizeOrNil returns the requests storage size, expressed in the
+// units it has been written with
 func (s *StorageConfiguration) GetSizeOrNil() *resource.Quantity {
 	if s == nil {
 		return nil
 	}
 
-	if s.Size != "" {
-		quantity, err := resource.ParseQuantity(s.Size)
+	var quantity resource.Quantity
+	switch {
+	case s.Size != "":
+		parsed, err := resource.ParseQuantity(s.Size)
 		if err != nil {
 			return nil
 		}
+		quantity = parsed
 
-		return &quantity
-	}
+	case s.PersistentVolumeClaimTemplate != nil:
+		quantity = *s.PersistentVolumeClaimTemplate.Resources.Requests.Storage()
 
-	if s.PersistentVolumeClaimTemplate != nil {
-		return s.PersistentVolumeClaimTemplate.Resources.Requests.Storage()
+	default:
+		return nil
 	}
 
-	return nil
+	return &quantity
 }
 
 // AreDefaultQueriesDisabled checks whether default monitoring queries should be disabled
@@ -823,11 +883,7 @@ func (cluster *Cluster) IsInstanceFenced(instance string) bool {
 // ShouldResizeInUseVolumes is true when we should resize PVC we already
 // created
 func (cluster *Cluster) ShouldResizeInUseVolumes() bool {
-	if cluster.Spec.StorageConfiguration.ResizeInUseVolumes == nil {
-		return true
-	}
-
-	return *cluster.Spec.StorageConfiguration.ResizeInUseVolumes
+	return cluster.Spec.StorageConfiguration.ShouldResizeInUseVolumes()
 }
 
 // ShouldCreateApplicationSecret returns true if for this cluster,
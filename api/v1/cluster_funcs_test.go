@@ -325,6 +325,56 @@ var _ = Describe("default UID/GID", func() {
 	})
 })
 
+var _ = Describe("storage size parsing", func() {
+	It("accepts valid storage sizes", func() {
+		for _, value := range []string{"1Gi", "500M", "1.5Gi", "1024"} {
+			quantity, err := ParseStorageSize(value)
+			Expect(err).ToNot(HaveOccurred(), value)
+			Expect(quantity).To(BeComparableTo(resource.MustParse(value)), value)
+		}
+	})
+
+	It("rejects invalid storage sizes", func() {
+		for _, value := range []string{"", "one gigabyte", "0", "-1Gi", "0.5", "1500m"} {
+			_, err := ParseStorageSize(value)
+			Expect(err).To(HaveOccurred(), value)
+			Expect(err.Error()).To(HavePrefix("invalid storage size"))
+		}
+	})
+
+	It("converts the storage sizes to binary SI", func() {
+		for value, expected := range map[string]string{
+			"1Gi":        "1Gi",
+			"1024Mi":     "1Gi",
+			"1073741824": "1Gi",
+			"1.5Gi":      "1536Mi",
+			"1G":         "1000000000",
+		} {
+			quantity, err := ParseStorageSize(value)
+			Expect(err).ToNot(HaveOccurred(), value)
+			Expect(quantity.String()).To(Equal(expected), value)
+		}
+	})
+
+	It("gets the size of a storage configuration in the units it has been written with", func() {
+		Expect((&StorageConfiguration{Size: "2048Mi"}).GetSizeOrNil().String()).To(Equal("2Gi"))
+		Expect((&StorageConfiguration{Size: "500M"}).GetSizeOrNil().String()).To(Equal("500M"))
+		Expect((&StorageConfiguration{Size: "100G"}).GetSizeOrNil().String()).To(Equal("100G"))
+		Expect((&StorageConfiguration{Size: "one gigabyte"}).GetSizeOrNil()).To(BeNil())
+		Expect((&StorageConfiguration{
+			PersistentVolumeClaimTemplate: &corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("500M")},
+				},
+			},
+		}).GetSizeOrNil().String()).To(Equal("500M"))
+	})
+
+	It("keeps the sizes accepted before they were validated", func() {
+		Expect((&StorageConfiguration{Size: "1500m"}).GetSizeOrNil().String()).To(Equal("1500m"))
+	})
+})
+
 var _ = Describe("resize in use volumes", func() {
 	It("is enabled by default", func() {
 		cluster := Cluster{}
@@ -342,6 +392,13 @@ var _ = Describe("resize in use volumes", func() {
 		}
 		Expect(cluster.ShouldResizeInUseVolumes()).To(BeFalse())
 	})
+	It("can be disabled for a single storage configuration", func() {
+		falseValue := false
+		var nilStorage *StorageConfiguration
+		Expect(nilStorage.ShouldResizeInUseVolumes()).To(BeTrue())
+		Expect((&StorageConfiguration{}).ShouldResizeInUseVolumes()).To(BeTrue())
+		Expect((&StorageConfiguration{ResizeInUseVolumes: &falseValue}).ShouldResizeInUseVolumes()).To(BeFalse())
+	})
 })
 
 var _ = Describe("external cluster list", func() {
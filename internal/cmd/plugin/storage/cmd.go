@@ -0,0 +1,76 @@
+/*
+Copyright © contributors to CloudNativePG, established as
+CloudNativePG a Series of LF Projects, LLC.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package storage
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cloudnative-pg/cloudnative-pg/internal/cmd/plugin"
+)
+
+// NewCmd creates the new "storage" command
+func NewCmd() *cobra.Command {
+	storageCmd := &cobra.Command{
+		Use:     "storage",
+		Short:   "Storage related commands",
+		GroupID: plugin.GroupIDCluster,
+	}
+
+	storageCmd.AddCommand(newHistoryCmd())
+
+	return storageCmd
+}
+
+func newHistoryCmd() *cobra.Command {
+	historyCmd := &cobra.Command{
+		Use:   "history CLUSTER",
+		Short: "Show the chronological history of the storage events of a cluster's volumes",
+		Long: "Show the chronological history of the storage events (resizing, " +
+			"filesystem expansion, provisioning failures) concerning the " +
+			"PersistentVolumeClaims of the cluster named CLUSTER",
+		Args: plugin.RequiresArguments(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return plugin.CompleteClusters(cmd.Context(), args, toComplete), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clusterName := args[0]
+
+			volume, _ := cmd.Flags().GetString("volume")
+			since, _ := cmd.Flags().GetDuration("since")
+			output, _ := cmd.Flags().GetString("output")
+
+			return History(cmd.Context(), clusterName, historyOptions{
+				volume: volume,
+				since:  since,
+			}, plugin.OutputFormat(output))
+		},
+	}
+
+	historyCmd.Flags().String(
+		"volume", "",
+		"Only show the events of this volume. Accepts a PVC name, 'data', 'wal' or the name of a tablespace")
+	historyCmd.Flags().Duration(
+		"since", 0,
+		"Only show the events newer than a relative duration like 5s, 2m, or 3h")
+	historyCmd.Flags().StringP(
+		"output", "o", "text", "Output format. One of text|json")
+
+	return historyCmd
+}
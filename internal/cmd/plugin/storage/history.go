@@ -0,0 +1,287 @@
+/*
+Copyright © contributors to CloudNativePG, established as
+CloudNativePG a Series of LF Projects, LLC.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cheynewallace/tabby"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+	"github.com/cloudnative-pg/cloudnative-pg/internal/cmd/plugin"
+	"github.com/cloudnative-pg/cloudnative-pg/pkg/utils"
+)
+
+// clusterSizingEventReasons are the reasons of the events recorded on the
+// Cluster when the operator takes a decision about the size of a PVC
+var clusterSizingEventReasons = []string{
+	"ResizeDeferred",
+	"QueuedBehindFleetLimit",
+	"AdoptedExternalSize",
+	"WaitingForBinding",
+	"ResizeManagedExternally",
+	"ResizeExcluded",
+}
+
+type historyOptions struct {
+	// volume is a PVC name, "data", "wal" or a tablespace name.
+	// An empty value matches every volume
+	volume string
+
+	// since restricts the history to the events newer than this
+	// duration. Zero means no restriction
+	since time.Duration
+}
+
+// HistoryEntry is a single storage event concerning a volume of the cluster
+type HistoryEntry struct {
+	// Timestamp is the last time the event was observed
+	Timestamp time.Time `json:"timestamp"`
+
+	// PVCName is the name of the PersistentVolumeClaim the event refers to
+	PVCName string `json:"pvcName"`
+
+	// Instance is the name of the instance owning the volume
+	Instance string `json:"instance,omitempty"`
+
+	// Role is the role of the volume (PG_DATA, PG_WAL, PG_TABLESPACE)
+	Role string `json:"role,omitempty"`
+
+	// Tablespace is the name of the tablespace, if the volume holds one
+	Tablespace string `json:"tablespace,omitempty"`
+
+	// Type is the type of the event (Normal, Warning)
+	Type string `json:"type"`
+
+	// Reason is the reason of the event
+	Reason string `json:"reason"`
+
+	// Message is the message of the event
+	Message string `json:"message"`
+
+	// Count is the number of times the event has been observed
+	Count int32 `json:"count,omitempty"`
+}
+
+// History command implementation
+func History(
+	ctx context.Context,
+	clusterName string,
+	options historyOptions,
+	format plugin.OutputFormat,
+) error {
+	var cluster apiv1.Cluster
+	if err := plugin.Client.Get(
+		ctx,
+		client.ObjectKey{Namespace: plugin.Namespace, Name: clusterName},
+		&cluster,
+	); err != nil {
+		return fmt.Errorf("could not get cluster: %w", err)
+	}
+
+	entries, err := getHistory(ctx, plugin.Client, &cluster, options, time.Now())
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case plugin.OutputFormatText:
+		printHistory(entries)
+		return nil
+	default:
+		return plugin.Print(entries, format, os.Stdout)
+	}
+}
+
+func getHistory(
+	ctx context.Context,
+	cli client.Client,
+	cluster *apiv1.Cluster,
+	options historyOptions,
+	now time.Time,
+) ([]HistoryEntry, error) {
+	var pvcs corev1.PersistentVolumeClaimList
+	if err := cli.List(
+		ctx,
+		&pvcs,
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{utils.ClusterLabelName: cluster.Name},
+	); err != nil {
+		return nil, fmt.Errorf("could not get cluster PVCs: %w", err)
+	}
+
+	pvcByName := make(map[string]*corev1.PersistentVolumeClaim, len(pvcs.Items))
+	for idx := range pvcs.Items {
+		pvc := &pvcs.Items[idx]
+		if options.volume != "" && !volumeMatches(cluster, pvc, options.volume) {
+			continue
+		}
+		pvcByName[pvc.Name] = pvc
+	}
+
+	var pvcEvents corev1.EventList
+	if err := cli.List(
+		ctx,
+		&pvcEvents,
+		client.InNamespace(cluster.Namespace),
+		client.MatchingFields{"involvedObject.kind": "PersistentVolumeClaim"},
+	); err != nil {
+		return nil, fmt.Errorf("could not get PVC events: %w", err)
+	}
+
+	var clusterEvents corev1.EventList
+	if err := cli.List(
+		ctx,
+		&clusterEvents,
+		client.InNamespace(cluster.Namespace),
+		client.MatchingFields{
+			"involvedObject.kind": apiv1.ClusterKind,
+			"involvedObject.name": cluster.Name,
+		},
+	); err != nil {
+		return nil, fmt.Errorf("could not get cluster events: %w", err)
+	}
+
+	entries := make([]HistoryEntry, 0)
+	appendEntry := func(event *corev1.Event, pvcName string) {
+		pvc, ok := pvcByName[pvcName]
+		if !ok {
+			return
+		}
+
+		timestamp := eventTimestamp(event)
+		if options.since > 0 && timestamp.Before(now.Add(-options.since)) {
+			return
+		}
+
+		entries = append(entries, HistoryEntry{
+			Timestamp:  timestamp,
+			PVCName:    pvc.Name,
+			Instance:   pvc.Labels[utils.InstanceNameLabelName],
+			Role:       pvc.Labels[utils.PvcRoleLabelName],
+			Tablespace: pvc.Labels[utils.TablespaceNameLabelName],
+			Type:       event.Type,
+			Reason:     event.Reason,
+			Message:    event.Message,
+			Count:      event.Count,
+		})
+	}
+
+	for idx := range pvcEvents.Items {
+		event := &pvcEvents.Items[idx]
+		appendEntry(event, event.InvolvedObject.Name)
+	}
+
+	for idx := range clusterEvents.Items {
+		event := &clusterEvents.Items[idx]
+		if !slices.Contains(clusterSizingEventReasons, event.Reason) {
+			continue
+		}
+		appendEntry(event, getPVCNameFromMessage(event.Message))
+	}
+
+	slices.SortStableFunc(entries, func(a, b HistoryEntry) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+
+	return entries, nil
+}
+
+// getPVCNameFromMessage extracts the name of the PVC from the message of
+// a sizing event recorded on the Cluster, where it follows the word "PVC"
+func getPVCNameFromMessage(message string) string {
+	words := strings.Fields(message)
+	for idx := 0; idx < len(words)-1; idx++ {
+		if words[idx] == "PVC" {
+			return strings.TrimRight(words[idx+1], ",.")
+		}
+	}
+
+	return ""
+}
+
+// volumeMatches checks if the passed PVC is selected by the volume filter,
+// which can be the PVC name, the name of a tablespace, "data" or "wal".
+// Tablespace names take precedence, so that a tablespace named "data"
+// or "wal" can be selected
+func volumeMatches(cluster *apiv1.Cluster, pvc *corev1.PersistentVolumeClaim, volume string) bool {
+	if pvc.Name == volume {
+		return true
+	}
+
+	role := utils.PVCRole(pvc.Labels[utils.PvcRoleLabelName])
+	if cluster.GetTablespaceConfiguration(volume) != nil {
+		return role == utils.PVCRolePgTablespace && pvc.Labels[utils.TablespaceNameLabelName] == volume
+	}
+
+	switch strings.ToLower(volume) {
+	case "data":
+		return role == utils.PVCRolePgData
+	case "wal":
+		return role == utils.PVCRolePgWal
+	}
+
+	return role == utils.PVCRolePgTablespace && pvc.Labels[utils.TablespaceNameLabelName] == volume
+}
+
+// eventTimestamp gets the most relevant timestamp of an event, taking into
+// account that events created with the events.k8s.io API don't have the
+// LastTimestamp field set
+func eventTimestamp(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+func printHistory(entries []HistoryEntry) {
+	if len(entries) == 0 {
+		fmt.Println("No storage events found")
+		return
+	}
+
+	history := tabby.New()
+	history.AddHeader("Timestamp", "PVC", "Instance", "Role", "Type", "Reason", "Message")
+	for _, entry := range entries {
+		history.AddLine(
+			entry.Timestamp.Format(time.RFC3339),
+			entry.PVCName,
+			entry.Instance,
+			entry.Role,
+			entry.Type,
+			entry.Reason,
+			entry.Message,
+		)
+	}
+	history.Print()
+}
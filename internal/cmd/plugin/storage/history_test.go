@@ -0,0 +1,215 @@
+/*
+Copyright © contributors to CloudNativePG, established as
+CloudNativePG a Series of LF Projects, LLC.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package storage
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8client "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+	schemeBuilder "github.com/cloudnative-pg/cloudnative-pg/internal/scheme"
+	"github.com/cloudnative-pg/cloudnative-pg/pkg/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("storage history", func() {
+	const namespace = "test-namespace"
+
+	var (
+		cluster *apiv1.Cluster
+		cli     k8client.Client
+		now     time.Time
+	)
+
+	makePVC := func(
+		clusterName, name, instance string,
+		role utils.PVCRole,
+		tablespace string,
+	) *corev1.PersistentVolumeClaim {
+		labels := map[string]string{
+			utils.ClusterLabelName:      clusterName,
+			utils.InstanceNameLabelName: instance,
+			utils.PvcRoleLabelName:      string(role),
+		}
+		if tablespace != "" {
+			labels[utils.TablespaceNameLabelName] = tablespace
+		}
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    labels,
+			},
+		}
+	}
+
+	makeEvent := func(name, kind, objectName, reason string, age time.Duration) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			InvolvedObject: corev1.ObjectReference{
+				Kind:      kind,
+				Name:      objectName,
+				Namespace: namespace,
+			},
+			Reason:        reason,
+			Type:          corev1.EventTypeNormal,
+			LastTimestamp: metav1.NewTime(now.Add(-age)),
+		}
+	}
+
+	// newClient builds a client indexing the events by the kind and
+	// the name of the involved object, like the API server does
+	newClient := func(objects ...k8client.Object) k8client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(schemeBuilder.BuildWithAllKnownScheme()).
+			WithIndex(&corev1.Event{}, "involvedObject.kind", func(obj k8client.Object) []string {
+				return []string{obj.(*corev1.Event).InvolvedObject.Kind}
+			}).
+			WithIndex(&corev1.Event{}, "involvedObject.name", func(obj k8client.Object) []string {
+				return []string{obj.(*corev1.Event).InvolvedObject.Name}
+			}).
+			WithObjects(objects...).
+			Build()
+	}
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+		cluster = &apiv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-example",
+				Namespace: namespace,
+			},
+		}
+
+		cli = newClient(
+			cluster,
+			makePVC(cluster.Name, "cluster-example-1", "cluster-example-1", utils.PVCRolePgData, ""),
+			makePVC(cluster.Name, "cluster-example-1-wal", "cluster-example-1", utils.PVCRolePgWal, ""),
+			makePVC(cluster.Name, "cluster-example-1-tbs1", "cluster-example-1", utils.PVCRolePgTablespace, "tbs1"),
+			makePVC("another-cluster", "another-cluster-1", "another-cluster-1", utils.PVCRolePgData, ""),
+			makeEvent("e1", "PersistentVolumeClaim", "cluster-example-1-wal", "Resizing", time.Hour),
+			makeEvent("e2", "PersistentVolumeClaim", "cluster-example-1", "Resizing", 2*time.Hour),
+			makeEvent("e3", "PersistentVolumeClaim", "cluster-example-1", "FileSystemResizeSuccessful", time.Minute),
+			makeEvent("e4", "PersistentVolumeClaim", "cluster-example-1-tbs1", "Resizing", 3*time.Hour),
+			makeEvent("e5", "PersistentVolumeClaim", "another-cluster-1", "Resizing", time.Minute),
+			makeEvent("e6", "Pod", "cluster-example-1", "Started", time.Minute),
+		)
+	})
+
+	It("returns the events of the cluster volumes in chronological order", func(ctx SpecContext) {
+		entries, err := getHistory(ctx, cli, cluster, historyOptions{}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(4))
+		Expect(entries[0].PVCName).To(Equal("cluster-example-1-tbs1"))
+		Expect(entries[0].Tablespace).To(Equal("tbs1"))
+		Expect(entries[1].PVCName).To(Equal("cluster-example-1"))
+		Expect(entries[2].PVCName).To(Equal("cluster-example-1-wal"))
+		Expect(entries[2].Role).To(Equal(string(utils.PVCRolePgWal)))
+		Expect(entries[3].Reason).To(Equal("FileSystemResizeSuccessful"))
+		Expect(entries[3].Instance).To(Equal("cluster-example-1"))
+	})
+
+	It("filters the events by volume", func(ctx SpecContext) {
+		entries, err := getHistory(ctx, cli, cluster, historyOptions{volume: "data"}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		for _, entry := range entries {
+			Expect(entry.PVCName).To(Equal("cluster-example-1"))
+		}
+
+		entries, err = getHistory(ctx, cli, cluster, historyOptions{volume: "tbs1"}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].PVCName).To(Equal("cluster-example-1-tbs1"))
+
+		entries, err = getHistory(ctx, cli, cluster, historyOptions{volume: "cluster-example-1-wal"}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("selects the tablespaces named like a volume role", func(ctx SpecContext) {
+		cluster.Spec.Tablespaces = []apiv1.TablespaceConfiguration{{Name: "data"}}
+		cli = newClient(
+			cluster,
+			makePVC(cluster.Name, "cluster-example-1", "cluster-example-1", utils.PVCRolePgData, ""),
+			makePVC(cluster.Name, "cluster-example-1-tbs-data", "cluster-example-1", utils.PVCRolePgTablespace, "data"),
+			makeEvent("e1", "PersistentVolumeClaim", "cluster-example-1", "Resizing", time.Hour),
+			makeEvent("e2", "PersistentVolumeClaim", "cluster-example-1-tbs-data", "Resizing", time.Hour),
+		)
+
+		entries, err := getHistory(ctx, cli, cluster, historyOptions{volume: "data"}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].PVCName).To(Equal("cluster-example-1-tbs-data"))
+	})
+
+	It("includes the sizing decisions recorded on the cluster", func(ctx SpecContext) {
+		deferred := makeEvent("c1", apiv1.ClusterKind, cluster.Name, "ResizeDeferred", 30*time.Minute)
+		deferred.Message = "Deferring the resize of PVC cluster-example-1-wal while a volume snapshot " +
+			"backup of instance cluster-example-1 is running"
+		queued := makeEvent("c2", apiv1.ClusterKind, cluster.Name, "QueuedBehindFleetLimit", 4*time.Hour)
+		queued.Message = "Queuing the resize of PVC cluster-example-1, the maximum number of 1 " +
+			"concurrent PVC expansions has been reached"
+		unrelated := makeEvent("c3", apiv1.ClusterKind, cluster.Name, "SwitchoverInitiated", time.Minute)
+		unrelated.Message = "Initiating switchover to cluster-example-2"
+		otherCluster := makeEvent("c4", apiv1.ClusterKind, "another-cluster", "ResizeDeferred", time.Minute)
+		otherCluster.Message = "Deferring the resize of PVC another-cluster-1 while a volume snapshot " +
+			"backup of instance another-cluster-1 is running"
+		for _, event := range []*corev1.Event{deferred, queued, unrelated, otherCluster} {
+			Expect(cli.Create(ctx, event)).To(Succeed())
+		}
+
+		entries, err := getHistory(ctx, cli, cluster, historyOptions{}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(6))
+		Expect(entries[0].Reason).To(Equal("QueuedBehindFleetLimit"))
+		Expect(entries[0].PVCName).To(Equal("cluster-example-1"))
+
+		entries, err = getHistory(ctx, cli, cluster, historyOptions{volume: "wal", since: 45 * time.Minute}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Reason).To(Equal("ResizeDeferred"))
+		Expect(entries[0].PVCName).To(Equal("cluster-example-1-wal"))
+	})
+
+	It("filters the events by age", func(ctx SpecContext) {
+		entries, err := getHistory(ctx, cli, cluster, historyOptions{since: 90 * time.Minute}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Reason).To(Equal("Resizing"))
+		Expect(entries[1].Reason).To(Equal("FileSystemResizeSuccessful"))
+	})
+})
+
+var _ = Describe("eventTimestamp", func() {
+	It("falls back to the event time when the last timestamp is not set", func() {
+		eventTime := metav1.NewMicroTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		Expect(eventTimestamp(&corev1.Event{EventTime: eventTime})).To(Equal(eventTime.Time))
+	})
+})
@@ -175,6 +175,15 @@ type Data struct {
 
 	// DrainTaints is a list of taints the operator will watch and treat as Unschedule
 	DrainTaints []string `json:"drainTaints" env:"DRAIN_TAINTS"`
+
+	// EnablePVCAuditLog enables the audit records of every mutation
+	// made by the operator to the PVCs, written by the "pvc_audit" logger
+	EnablePVCAuditLog bool `json:"enablePVCAuditLog" env:"ENABLE_PVC_AUDIT_LOG"`
+
+	// MaxConcurrentPVCExpansions is the maximum number of PVC expansions
+	// that can be in progress at the same time across all the clusters
+	// managed by the operator. Zero means no limit
+	MaxConcurrentPVCExpansions int `json:"maxConcurrentPVCExpansions" env:"MAX_CONCURRENT_PVC_EXPANSIONS"`
 }
 
 // Current is the configuration used by the operator
@@ -66,6 +66,11 @@ const backupPhase = ".status.phase"
 // where the name of the cluster is written
 const clusterNameField = ".spec.cluster.name"
 
+// pvcExpansionWaitTimeout is the maximum time, since the creation of
+// the backup, a volume snapshot backup waits for the expansion of the
+// PVCs of the target instance to complete before being started anyway
+const pvcExpansionWaitTimeout = 10 * time.Minute
+
 // ErrPrimaryImageNeedsUpdate is returned when the primary instance is not running with the latest image
 var ErrPrimaryImageNeedsUpdate = fmt.Errorf("primary instance not having expected image, cannot run backup")
 
@@ -636,7 +641,55 @@ func (r *BackupReconciler) reconcileSnapshotBackup(
 		return nil, fmt.Errorf("target pod lacks container statuses")
 	}
 
+	pvcs, err := persistentvolumeclaim.GetInstancePVCs(ctx, r.Client, targetPod.Name, cluster.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get PVCs: %w", err)
+	}
+
 	if len(backup.Status.Phase) == 0 || backup.Status.Phase == apiv1.BackupPhasePending {
+		// Taking a snapshot of a volume that is being expanded fails
+		// on several CSI drivers, let's wait for the expansion to complete.
+		// Some expansions never complete on their own, e.g. when the
+		// file system can't be resized online or the expansion failed,
+		// so we only wait for a limited time
+		pvcName := getExpandingPVCName(pvcs)
+		waitingTime := time.Since(backup.CreationTimestamp.Time)
+		if pvcName != "" && waitingTime < pvcExpansionWaitTimeout {
+			r.Recorder.Eventf(
+				backup,
+				"Normal",
+				"WaitingForPVCExpansion",
+				"Waiting for the expansion of PVC %s to complete, will retry in 30 seconds",
+				pvcName,
+			)
+			contextLogger.Info(
+				"Waiting for PVC expansion to complete before taking the snapshot backup, will retry in 30 seconds",
+				"pvcName", pvcName,
+			)
+			origBackup := backup.DeepCopy()
+			backup.Status.SetAsPending()
+			if err := r.Status().Patch(ctx, backup, client.MergeFrom(origBackup)); err != nil {
+				return nil, err
+			}
+
+			return &ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+		if pvcName != "" {
+			r.Recorder.Eventf(
+				backup,
+				"Warning",
+				"PVCExpansionWaitTimeout",
+				"PVC %s is still being expanded after %s, starting the snapshot backup anyway",
+				pvcName,
+				pvcExpansionWaitTimeout,
+			)
+			contextLogger.Warning(
+				"PVC expansion not completed in time, starting the snapshot backup anyway",
+				"pvcName", pvcName,
+				"timeout", pvcExpansionWaitTimeout,
+			)
+		}
+
 		pgContainerStatus, err := getPostgresContainerStatus(targetPod)
 		if err != nil {
 			return nil, fmt.Errorf("cannot get postgres container status: %w", err)
@@ -668,11 +721,6 @@ func (r *BackupReconciler) reconcileSnapshotBackup(
 		contextLogger.Error(errCond, "Error while updating backup condition (backup starting)")
 	}
 
-	pvcs, err := persistentvolumeclaim.GetInstancePVCs(ctx, r.Client, targetPod.Name, cluster.Namespace)
-	if err != nil {
-		return nil, fmt.Errorf("cannot get PVCs: %w", err)
-	}
-
 	res, err := r.vsr.Reconcile(ctx, cluster, backup, targetPod, pvcs)
 	if err != nil {
 		// Volume Snapshot errors are not retryable, we need to set this backup as failed
@@ -704,6 +752,18 @@ func (r *BackupReconciler) reconcileSnapshotBackup(
 	return nil, nil
 }
 
+// getExpandingPVCName returns the name of the first PVC being expanded,
+// or an empty string if none is
+func getExpandingPVCName(pvcs []corev1.PersistentVolumeClaim) string {
+	for _, pvc := range pvcs {
+		if persistentvolumeclaim.IsExpansionInProgress(pvc) {
+			return pvc.Name
+		}
+	}
+
+	return ""
+}
+
 func (r *BackupReconciler) getSnapshotTargetPod(
 	ctx context.Context,
 	cluster *apiv1.Cluster,
@@ -563,7 +563,7 @@ func (r *ClusterReconciler) reconcile(ctx context.Context, cluster *apiv1.Cluste
 	}
 
 	// Updates all the objects managed by the controller
-	res, err := r.reconcileResources(ctx, cluster, resources, instancesStatus)
+	res, deferred, err := r.reconcileResources(ctx, cluster, resources, instancesStatus)
 	if err != nil || !res.IsZero() {
 		return res, err
 	}
@@ -576,7 +576,14 @@ func (r *ClusterReconciler) reconcile(ctx context.Context, cluster *apiv1.Cluste
 		return hookResult.Result, hookResult.Err
 	}
 
-	return setStatusPluginHook(ctx, r.Client, cnpgiClient.GetPluginClientFromContext(ctx), cluster)
+	res, err = setStatusPluginHook(ctx, r.Client, cnpgiClient.GetPluginClientFromContext(ctx), cluster)
+	if err != nil || !res.IsZero() {
+		return res, err
+	}
+
+	// The deferred changes are retried once the whole reconciliation
+	// loop has been completed
+	return deferred, nil
 }
 
 func (r *ClusterReconciler) ensureNoFailoverOnFullDisk(
@@ -738,11 +745,13 @@ func (r *ClusterReconciler) setDefaults(ctx context.Context, cluster *apiv1.Clus
 	return nil
 }
 
-// reconcileResources updates all the objects managed by the controller
+// reconcileResources updates all the objects managed by the controller.
+// The second result reports the changes that have been deferred without
+// stopping the reconciliation, which needs to be retried once completed
 func (r *ClusterReconciler) reconcileResources(
 	ctx context.Context, cluster *apiv1.Cluster,
 	resources *managedResources, instancesStatus postgres.PostgresqlStatusList,
-) (ctrl.Result, error) {
+) (ctrl.Result, ctrl.Result, error) {
 	contextLogger := log.FromContext(ctx)
 	runningJobs := resources.runningJobNames()
 
@@ -750,21 +759,21 @@ func (r *ClusterReconciler) reconcileResources(
 
 	if len(runningJobs) > 0 {
 		contextLogger.Debug("A job is currently running. Waiting", "runningJobs", runningJobs)
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, ctrl.Result{}, nil
 	}
 
 	if result, err := r.deleteTerminatedPods(ctx, cluster, resources); err != nil {
 		contextLogger.Error(err, "While deleting terminated pods")
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, ctrl.Result{}, nil
 	} else if result != nil {
-		return *result, nil
+		return *result, ctrl.Result{}, nil
 	}
 
 	if result, err := r.processUnschedulableInstances(ctx, cluster, resources); err != nil {
 		contextLogger.Error(err, "While processing unschedulable instances")
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, ctrl.Result{}, nil
 	} else if result != nil {
-		return *result, err
+		return *result, ctrl.Result{}, err
 	}
 
 	if !resources.allInstancesAreActive() {
@@ -791,22 +800,24 @@ func (r *ClusterReconciler) reconcileResources(
 				apiv1.PhaseWaitingForInstancesToBeActive,
 				"Some instances are not yet active. Please wait.",
 			); err != nil {
-				return ctrl.Result{}, err
+				return ctrl.Result{}, ctrl.Result{}, err
 			}
 		}
 
 		// Requeue reconciliation after a short delay
-		return ctrl.Result{RequeueAfter: time.Second}, nil
+		return ctrl.Result{RequeueAfter: time.Second}, ctrl.Result{}, nil
 	}
 
-	if res, err := persistentvolumeclaim.Reconcile(
+	res, deferred, err := persistentvolumeclaim.Reconcile(
 		ctx,
 		r.Client,
+		r.Recorder,
 		cluster,
 		resources.instances.Items,
 		resources.pvcs.Items,
-	); err != nil || !res.IsZero() {
-		return res, err
+	)
+	if err != nil || !res.IsZero() {
+		return res, ctrl.Result{}, err
 	}
 
 	// In-place Postgres major version upgrades
@@ -818,42 +829,42 @@ func (r *ClusterReconciler) reconcileResources(
 		resources.pvcs.Items,
 		resources.jobs.Items,
 	); err != nil {
-		return ctrl.Result{}, fmt.Errorf("cannot reconcile in-place major version upgrades: %w", err)
+		return ctrl.Result{}, ctrl.Result{}, fmt.Errorf("cannot reconcile in-place major version upgrades: %w", err)
 	} else if result != nil {
-		return *result, err
+		return *result, ctrl.Result{}, err
 	}
 
 	// Reconcile Pods
 	if res, err := r.reconcilePods(ctx, cluster, resources, instancesStatus); !res.IsZero() || err != nil {
-		return res, err
+		return res, ctrl.Result{}, err
 	}
 
 	if len(resources.instances.Items) > 0 && resources.noInstanceIsAlive() {
 		if err := r.RegisterPhase(ctx, cluster, apiv1.PhaseUnrecoverable,
 			"No pods are active, the cluster needs manual intervention "); err != nil {
-			return ctrl.Result{}, err
+			return ctrl.Result{}, ctrl.Result{}, err
 		}
-		return ctrl.Result{RequeueAfter: 1 * time.Second}, nil
+		return ctrl.Result{RequeueAfter: 1 * time.Second}, ctrl.Result{}, nil
 	}
 
 	// If we still need more instances, we need to wait before setting healthy status
 	if instancesStatus.InstancesReportingStatus() != cluster.Spec.Instances {
-		return ctrl.Result{RequeueAfter: 1 * time.Second}, ErrNextLoop
+		return ctrl.Result{RequeueAfter: 1 * time.Second}, ctrl.Result{}, ErrNextLoop
 	}
 
 	// PhaseInplacePrimaryRestart will be patched to healthy in instance manager
 	if cluster.Status.Phase == apiv1.PhaseInplacePrimaryRestart {
-		return ctrl.Result{RequeueAfter: 1 * time.Second}, ErrNextLoop
+		return ctrl.Result{RequeueAfter: 1 * time.Second}, ctrl.Result{}, ErrNextLoop
 	}
 
 	// When everything is reconciled, update the status
 	if err := r.RegisterPhase(ctx, cluster, apiv1.PhaseHealthy, ""); err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, ctrl.Result{}, err
 	}
 
 	r.cleanupCompletedJobs(ctx, resources.jobs)
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, deferred, nil
 }
 
 // deleteTerminatedPods will delete the Pods that are terminated
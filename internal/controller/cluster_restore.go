@@ -346,6 +346,8 @@ func restoreOrphanPVCs(
 		if err := c.Patch(ctx, pvc, client.MergeFrom(pvcOrig)); err != nil {
 			return err
 		}
+
+		persistentvolumeclaim.AuditMetadataUpdate(ctx, "restoreOrphanPVCs", pvcOrig, pvc)
 	}
 
 	return nil
@@ -96,8 +96,12 @@ func (v *ClusterCustomValidator) ValidateCreate(_ context.Context, cluster *apiv
 	clusterLog.Info("Validation for Cluster upon creation", "name", cluster.GetName(), "namespace",
 		cluster.GetNamespace())
 
-	allErrs := v.validate(cluster)
+	allErrs := append(
+		v.validate(cluster),
+		v.validateStorageSizes(cluster, nil)...,
+	)
 	allWarnings := v.getAdmissionWarnings(cluster)
+	allWarnings = append(allWarnings, getExternalStorageResizerWarnings(cluster, nil)...)
 
 	if len(allErrs) == 0 {
 		return allWarnings, nil
@@ -124,6 +128,7 @@ func (v *ClusterCustomValidator) ValidateUpdate(
 		v.validateClusterChanges(cluster, oldCluster)...,
 	)
 	allWarnings := v.getAdmissionWarnings(cluster)
+	allWarnings = append(allWarnings, getExternalStorageResizerWarnings(cluster, oldCluster)...)
 
 	if len(allErrs) == 0 {
 		return allWarnings, nil
@@ -218,6 +223,7 @@ func (v *ClusterCustomValidator) validateClusterChanges(r, old *apiv1.Cluster) (
 		v.validateConfigurationChange,
 		v.validateStorageChange,
 		v.validateWalStorageChange,
+		v.validateStorageSizes,
 		v.validateTablespacesChange,
 		v.validateUnixPermissionIdentifierChange,
 		v.validateReplicationSlotsChange,
@@ -1621,9 +1627,9 @@ func validateStorageConfigurationSize(
 		}
 	}
 
-	if storageConfiguration.Size == "" &&
-		(storageConfiguration.PersistentVolumeClaimTemplate == nil ||
-			storageConfiguration.PersistentVolumeClaimTemplate.Resources.Requests.Storage().IsZero()) {
+	// An invalid storage request in the pvcTemplate is reported by
+	// validateStorageConfigurationQuantities
+	if storageConfiguration.Size == "" && getTemplateStorageRequest(storageConfiguration) == nil {
 		result = append(result, field.Invalid(
 			structPath.Child("size"),
 			storageConfiguration.Size,
@@ -1633,6 +1639,98 @@ func validateStorageConfigurationSize(
 	return result
 }
 
+// validateStorageSizes checks that the storage sizes of the cluster are
+// valid storage quantities. Older versions of the operator accepted sizes
+// like fractional numbers of bytes, and they are only rejected when changed,
+// so that the existing clusters can still be updated.
+// The old cluster is nil when the cluster is being created
+func (v *ClusterCustomValidator) validateStorageSizes(r, old *apiv1.Cluster) field.ErrorList {
+	var oldStorage, oldWalStorage *apiv1.StorageConfiguration
+	if old != nil {
+		oldStorage = &old.Spec.StorageConfiguration
+		oldWalStorage = old.Spec.WalStorage
+	}
+
+	result := validateStorageConfigurationQuantities(
+		field.NewPath("spec", "storage"), r.Spec.StorageConfiguration, oldStorage)
+
+	if r.ShouldCreateWalArchiveVolume() {
+		result = append(result, validateStorageConfigurationQuantities(
+			field.NewPath("spec", "walStorage"), *r.Spec.WalStorage, oldWalStorage)...)
+	}
+
+	for idx, tablespaceConf := range r.Spec.Tablespaces {
+		var oldTablespaceStorage *apiv1.StorageConfiguration
+		if old != nil {
+			if oldTablespaceConf := old.GetTablespaceConfiguration(tablespaceConf.Name); oldTablespaceConf != nil {
+				oldTablespaceStorage = &oldTablespaceConf.Storage
+			}
+		}
+
+		result = append(result, validateStorageConfigurationQuantities(
+			field.NewPath("spec", "tablespaces").Index(idx), tablespaceConf.Storage, oldTablespaceStorage)...)
+	}
+
+	return result
+}
+
+// validateStorageConfigurationQuantities checks the storage size and the
+// storage request in the pvcTemplate that differ from the old ones.
+// The old storage configuration is nil when it's being created
+func validateStorageConfigurationQuantities(
+	structPath *field.Path,
+	storageConfiguration apiv1.StorageConfiguration,
+	oldStorageConfiguration *apiv1.StorageConfiguration,
+) field.ErrorList {
+	var result field.ErrorList
+
+	if storageConfiguration.Size != "" &&
+		(oldStorageConfiguration == nil || oldStorageConfiguration.Size != storageConfiguration.Size) {
+		// Unparsable sizes are reported by validateStorageConfigurationSize
+		if quantity, err := resource.ParseQuantity(storageConfiguration.Size); err == nil {
+			if err := apiv1.ValidateStorageQuantity(quantity); err != nil {
+				result = append(result, field.Invalid(
+					structPath.Child("size"),
+					storageConfiguration.Size,
+					err.Error()))
+			}
+		}
+	}
+
+	if request := getTemplateStorageRequest(storageConfiguration); request != nil {
+		var oldRequest *resource.Quantity
+		if oldStorageConfiguration != nil {
+			oldRequest = getTemplateStorageRequest(*oldStorageConfiguration)
+		}
+
+		if oldRequest == nil || oldRequest.Cmp(*request) != 0 {
+			if err := apiv1.ValidateStorageQuantity(*request); err != nil {
+				result = append(result, field.Invalid(
+					structPath.Child("pvcTemplate", "resources", "requests", "storage"),
+					request.String(),
+					err.Error()))
+			}
+		}
+	}
+
+	return result
+}
+
+// getTemplateStorageRequest returns the storage request of the pvcTemplate,
+// or nil if it's not set
+func getTemplateStorageRequest(storageConfiguration apiv1.StorageConfiguration) *resource.Quantity {
+	if storageConfiguration.PersistentVolumeClaimTemplate == nil {
+		return nil
+	}
+
+	request, ok := storageConfiguration.PersistentVolumeClaimTemplate.Resources.Requests[corev1.ResourceStorage]
+	if !ok {
+		return nil
+	}
+
+	return &request
+}
+
 // Validate a change in the storage
 func (v *ClusterCustomValidator) validateStorageChange(r, old *apiv1.Cluster) field.ErrorList {
 	return validateStorageConfigurationChange(
@@ -2566,6 +2664,25 @@ func getStorageWarnings(r *apiv1.Cluster) admission.Warnings {
 			)
 		}
 
+		// Some CSI drivers and cloud quotas work with decimal units, and a
+		// binary size can exceed a decimal limit written with the same number
+		limit, hasLimit := configuration.PersistentVolumeClaimTemplate.Resources.Limits[corev1.ResourceStorage]
+		if request := configuration.GetSizeOrNil(); hasLimit && request != nil &&
+			isBinaryQuantity(*request) != isBinaryQuantity(limit) {
+			result = append(
+				result,
+				fmt.Sprintf(
+					"%s: the requested size %s (%d bytes) and the storage limit %s (%d bytes) "+
+						"of the pvcTemplate mix binary and decimal units.",
+					path.String(),
+					request.String(),
+					request.Value(),
+					limit.String(),
+					limit.Value(),
+				),
+			)
+		}
+
 		return result
 	}
 
@@ -2578,6 +2695,64 @@ func getStorageWarnings(r *apiv1.Cluster) admission.Warnings {
 	return append(result, generateWarningsFunc(walStoragePath, r.Spec.WalStorage)...)
 }
 
+// isBinaryQuantity checks if a quantity is expressed in binary units, like Gi
+func isBinaryQuantity(quantity resource.Quantity) bool {
+	return quantity.Format == resource.BinarySI
+}
+
+// getExternalStorageResizerWarnings warns that the storage size is not
+// applied to the existing PVCs when the external resizer annotation is
+// added, or when a storage size is changed while it's set. The old cluster
+// is nil when the cluster is being created
+func getExternalStorageResizerWarnings(r, old *apiv1.Cluster) admission.Warnings {
+	if !utils.IsExternalStorageResizerEnabled(&r.ObjectMeta) {
+		return nil
+	}
+
+	if old != nil &&
+		utils.IsExternalStorageResizerEnabled(&old.ObjectMeta) &&
+		!isStorageSizeChanged(r, old) {
+		return nil
+	}
+
+	return admission.Warnings{
+		fmt.Sprintf(
+			"the size of the PVCs is managed by an external resizer (%s annotation): "+
+				"changes to the storage size will only be applied to new PVCs",
+			utils.ExternalStorageResizerAnnotationName),
+	}
+}
+
+// isStorageSizeChanged checks if the size of any of the volumes of
+// the cluster has been changed
+func isStorageSizeChanged(r, old *apiv1.Cluster) bool {
+	sizeChanged := func(storage, oldStorage *apiv1.StorageConfiguration) bool {
+		size, oldSize := storage.GetSizeOrNil(), oldStorage.GetSizeOrNil()
+		if size == nil || oldSize == nil {
+			return size != oldSize
+		}
+		return size.Cmp(*oldSize) != 0
+	}
+
+	if sizeChanged(&r.Spec.StorageConfiguration, &old.Spec.StorageConfiguration) ||
+		sizeChanged(r.Spec.WalStorage, old.Spec.WalStorage) {
+		return true
+	}
+
+	for idx := range r.Spec.Tablespaces {
+		tablespace := &r.Spec.Tablespaces[idx]
+		oldTablespace := old.GetTablespaceConfiguration(tablespace.Name)
+		if oldTablespace == nil {
+			continue
+		}
+		if sizeChanged(&tablespace.Storage, &oldTablespace.Storage) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getInTreeBarmanWarnings(r *apiv1.Cluster) admission.Warnings {
 	var result admission.Warnings
 
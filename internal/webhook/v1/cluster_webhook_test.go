@@ -3996,6 +3996,99 @@ var _ = Describe("Storage configuration validation", func() {
 			}
 			Expect(v.validateStorageSize(cluster)).To(BeEmpty())
 		})
+
+		It("produces an error if the storage size is not a whole number of bytes", func() {
+			cluster := &apiv1.Cluster{
+				Spec: apiv1.ClusterSpec{
+					StorageConfiguration: apiv1.StorageConfiguration{
+						Size: "1500m",
+					},
+				},
+			}
+			Expect(v.validateStorageSize(cluster)).To(BeEmpty())
+			errs := v.validateStorageSizes(cluster, nil)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.storage.size"))
+			Expect(errs[0].Detail).To(Equal("must be a whole number of bytes"))
+		})
+
+		It("produces an error if the pvc template requests an invalid storage size", func() {
+			cluster := &apiv1.Cluster{
+				Spec: apiv1.ClusterSpec{
+					StorageConfiguration: apiv1.StorageConfiguration{
+						PersistentVolumeClaimTemplate: &corev1.PersistentVolumeClaimSpec{
+							Resources: corev1.VolumeResourceRequirements{
+								Requests: corev1.ResourceList{"storage": resource.MustParse("-1Gi")},
+							},
+						},
+					},
+				},
+			}
+			Expect(v.validateStorageSize(cluster)).To(BeEmpty())
+			errs := v.validateStorageSizes(cluster, nil)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.storage.pvcTemplate.resources.requests.storage"))
+		})
+
+		It("produces a single error if the pvc template requests an empty storage size", func() {
+			cluster := &apiv1.Cluster{
+				Spec: apiv1.ClusterSpec{
+					StorageConfiguration: apiv1.StorageConfiguration{
+						PersistentVolumeClaimTemplate: &corev1.PersistentVolumeClaimSpec{
+							Resources: corev1.VolumeResourceRequirements{
+								Requests: corev1.ResourceList{"storage": resource.MustParse("0")},
+							},
+						},
+					},
+				},
+			}
+			Expect(v.validateStorageSize(cluster)).To(BeEmpty())
+			errs := v.validateStorageSizes(cluster, nil)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.storage.pvcTemplate.resources.requests.storage"))
+		})
+	})
+
+	When("a Cluster is updated", func() {
+		var oldCluster *apiv1.Cluster
+		BeforeEach(func() {
+			oldCluster = &apiv1.Cluster{
+				Spec: apiv1.ClusterSpec{
+					StorageConfiguration: apiv1.StorageConfiguration{
+						Size: "1500m",
+					},
+					Tablespaces: []apiv1.TablespaceConfiguration{
+						{
+							Name: "tbs1",
+							Storage: apiv1.StorageConfiguration{
+								Size: "0",
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("accepts the storage sizes that haven't been changed", func() {
+			cluster := oldCluster.DeepCopy()
+			cluster.Spec.Instances = 3
+			Expect(v.validateStorageSizes(cluster, oldCluster)).To(BeEmpty())
+		})
+
+		It("rejects the invalid storage sizes that have been changed", func() {
+			cluster := oldCluster.DeepCopy()
+			cluster.Spec.StorageConfiguration.Size = "2500m"
+			cluster.Spec.Tablespaces = append(cluster.Spec.Tablespaces, apiv1.TablespaceConfiguration{
+				Name: "tbs2",
+				Storage: apiv1.StorageConfiguration{
+					Size: "0",
+				},
+			})
+			errs := v.validateStorageSizes(cluster, oldCluster)
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Field).To(Equal("spec.storage.size"))
+			Expect(errs[1].Field).To(Equal("spec.tablespaces[1].size"))
+		})
 	})
 })
 
@@ -5819,7 +5912,105 @@ var _ = Describe("getRetentionPolicyWarnings", func() {
 	})
 })
 
+var _ = Describe("getExternalStorageResizerWarnings", func() {
+	var cluster *apiv1.Cluster
+
+	BeforeEach(func() {
+		cluster = &apiv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					utils.ExternalStorageResizerAnnotationName: "true",
+				},
+			},
+			Spec: apiv1.ClusterSpec{
+				StorageConfiguration: apiv1.StorageConfiguration{
+					Size: "1Gi",
+				},
+			},
+		}
+	})
+
+	It("returns no warnings when the annotation is not set", func() {
+		Expect(getExternalStorageResizerWarnings(&apiv1.Cluster{}, nil)).To(BeEmpty())
+	})
+
+	It("returns a warning when a cluster using an external resizer is created", func() {
+		warnings := getExternalStorageResizerWarnings(cluster, nil)
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(ContainSubstring(utils.ExternalStorageResizerAnnotationName))
+	})
+
+	It("returns a warning when the annotation is added", func() {
+		oldCluster := cluster.DeepCopy()
+		oldCluster.Annotations = nil
+		Expect(getExternalStorageResizerWarnings(cluster, oldCluster)).To(HaveLen(1))
+	})
+
+	It("returns no warnings when the storage size is unchanged", func() {
+		oldCluster := cluster.DeepCopy()
+		Expect(getExternalStorageResizerWarnings(cluster, oldCluster)).To(BeEmpty())
+	})
+
+	It("returns a warning when a storage size is changed", func() {
+		oldCluster := cluster.DeepCopy()
+		cluster.Spec.StorageConfiguration.Size = "2Gi"
+		Expect(getExternalStorageResizerWarnings(cluster, oldCluster)).To(HaveLen(1))
+	})
+
+	It("returns a warning when the size of a tablespace is changed", func() {
+		cluster.Spec.Tablespaces = []apiv1.TablespaceConfiguration{
+			{
+				Name:    "tbs1",
+				Storage: apiv1.StorageConfiguration{Size: "1Gi"},
+			},
+		}
+		oldCluster := cluster.DeepCopy()
+		cluster.Spec.Tablespaces[0].Storage.Size = "2Gi"
+		Expect(getExternalStorageResizerWarnings(cluster, oldCluster)).To(HaveLen(1))
+	})
+})
+
 var _ = Describe("getStorageWarnings", func() {
+	It("returns a warning when the storage request and limit mix binary and decimal units", func() {
+		cluster := &apiv1.Cluster{
+			Spec: apiv1.ClusterSpec{
+				StorageConfiguration: apiv1.StorageConfiguration{
+					Size: "100Gi",
+					PersistentVolumeClaimTemplate: &corev1.PersistentVolumeClaimSpec{
+						Resources: corev1.VolumeResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceStorage: resource.MustParse("100G"),
+							},
+						},
+					},
+				},
+			},
+		}
+		warnings := getStorageWarnings(cluster)
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(ContainSubstring("spec.storage"))
+		Expect(warnings[0]).To(ContainSubstring("107374182400 bytes"))
+		Expect(warnings[0]).To(ContainSubstring("100000000000 bytes"))
+	})
+
+	It("returns no warnings when the storage request and limit use the same units", func() {
+		cluster := &apiv1.Cluster{
+			Spec: apiv1.ClusterSpec{
+				StorageConfiguration: apiv1.StorageConfiguration{
+					Size: "90G",
+					PersistentVolumeClaimTemplate: &corev1.PersistentVolumeClaimSpec{
+						Resources: corev1.VolumeResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceStorage: resource.MustParse("100G"),
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(getStorageWarnings(cluster)).To(BeEmpty())
+	})
+
 	It("returns no warnings when storage is properly configured", func() {
 		cluster := &apiv1.Cluster{
 			Spec: apiv1.ClusterSpec{
@@ -35,6 +35,7 @@ import (
 
 	apiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
 	"github.com/cloudnative-pg/cloudnative-pg/internal/configuration"
+	"github.com/cloudnative-pg/cloudnative-pg/pkg/reconciler/persistentvolumeclaim"
 	"github.com/cloudnative-pg/cloudnative-pg/pkg/resources/status"
 	"github.com/cloudnative-pg/cloudnative-pg/pkg/specs"
 	"github.com/cloudnative-pg/cloudnative-pg/pkg/utils"
@@ -223,7 +224,10 @@ func majorVersionUpgradeHandleCompletion(
 			if !errors.IsNotFound(err) {
 				return nil, err
 			}
+			continue
 		}
+
+		persistentvolumeclaim.AuditDeletion(ctx, "majorVersionUpgradeHandleCompletion", &pvc)
 	}
 
 	jobImage, ok := getTargetImageFromMajorUpgradeJob(job)
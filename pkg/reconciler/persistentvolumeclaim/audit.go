@@ -0,0 +1,148 @@
+/*
+Copyright © contributors to CloudNativePG, established as
+CloudNativePG a Series of LF Projects, LLC.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package persistentvolumeclaim
+
+import (
+	"context"
+
+	"github.com/cloudnative-pg/machinery/pkg/log"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/cloudnative-pg/cloudnative-pg/internal/configuration"
+	"github.com/cloudnative-pg/cloudnative-pg/pkg/utils"
+	"github.com/cloudnative-pg/cloudnative-pg/pkg/utils/hash"
+)
+
+// auditLoggerName is the name of the logger writing the audit records
+// of the PVC mutations, allowing them to be routed separately
+const auditLoggerName = "pvc_audit"
+
+// auditAction is the kind of mutation applied to a PVC
+type auditAction string
+
+const (
+	auditActionCreate                      auditAction = "create"
+	auditActionDelete                      auditAction = "delete"
+	auditActionResize                      auditAction = "resize"
+	auditActionChangeVolumeAttributesClass auditAction = "changeVolumeAttributesClass"
+	auditActionUpdateMetadata              auditAction = "updateMetadata"
+	auditActionUpdateStatus                auditAction = "updateStatus"
+)
+
+// auditRecord describes a mutation of a PVC made by the operator
+type auditRecord struct {
+	// action is the kind of mutation
+	action auditAction
+
+	// initiator is the reconciliation path that decided the mutation
+	initiator string
+
+	// clusterName is the name of the cluster owning the PVC. When
+	// empty, it's taken from the PVC labels
+	clusterName string
+
+	// oldPVC is the PVC before the mutation, nil when creating it
+	oldPVC *corev1.PersistentVolumeClaim
+
+	// newPVC is the PVC after the mutation, nil when deleting it
+	newPVC *corev1.PersistentVolumeClaim
+
+	// inputs are the data the decision was based on. Only their
+	// hash is written in the audit record
+	inputs interface{}
+}
+
+// auditPVCMutation writes the audit record of a PVC mutation, if the
+// audit log is enabled in the operator configuration
+func auditPVCMutation(ctx context.Context, record auditRecord) {
+	if !configuration.Current.EnablePVCAuditLog {
+		return
+	}
+
+	pvc := record.newPVC
+	if pvc == nil {
+		pvc = record.oldPVC
+	}
+	if pvc == nil {
+		return
+	}
+
+	clusterName := record.clusterName
+	if clusterName == "" {
+		clusterName = pvc.Labels[utils.ClusterLabelName]
+	}
+
+	values := []interface{}{
+		"action", record.action,
+		"initiator", record.initiator,
+		"cluster", clusterName,
+		"namespace", pvc.Namespace,
+		"pvcName", pvc.Name,
+	}
+	// The size is omitted when unknown, e.g. when deleting a PVC that
+	// has not been read
+	if record.oldPVC != nil {
+		if oldSize := getRequestedSize(record.oldPVC); oldSize != "" {
+			values = append(values, "oldSize", oldSize)
+		}
+	}
+	if record.newPVC != nil {
+		values = append(values, "newSize", getRequestedSize(record.newPVC))
+	}
+	if record.inputs != nil {
+		if inputsHash, err := hash.ComputeHash(record.inputs); err == nil {
+			values = append(values, "decisionInputsHash", inputsHash)
+		}
+	}
+
+	log.FromContext(ctx).WithName(auditLoggerName).Info("PVC mutation", values...)
+}
+
+// AuditDeletion writes the audit record of the deletion of a PVC
+// decided outside of this package
+func AuditDeletion(ctx context.Context, initiator string, pvc *corev1.PersistentVolumeClaim) {
+	auditPVCMutation(ctx, auditRecord{
+		action:    auditActionDelete,
+		initiator: initiator,
+		oldPVC:    pvc,
+	})
+}
+
+// AuditMetadataUpdate writes the audit record of a change to the
+// metadata of a PVC decided outside of this package
+func AuditMetadataUpdate(ctx context.Context, initiator string, oldPVC, newPVC *corev1.PersistentVolumeClaim) {
+	auditPVCMutation(ctx, auditRecord{
+		action:    auditActionUpdateMetadata,
+		initiator: initiator,
+		oldPVC:    oldPVC,
+		newPVC:    newPVC,
+	})
+}
+
+// getRequestedSize returns the storage size requested by a PVC
+// as a string, or an empty string if it's not set
+func getRequestedSize(pvc *corev1.PersistentVolumeClaim) string {
+	size, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if !ok {
+		return ""
+	}
+
+	return size.String()
+}
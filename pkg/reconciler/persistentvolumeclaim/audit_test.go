@@ -0,0 +1,124 @@
+/*
+Copyright © contributors to CloudNativePG, established as
+CloudNativePG a Series of LF Projects, LLC.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package persistentvolumeclaim
+
+import (
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/cloudnative-pg/cloudnative-pg/internal/configuration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PVC audit log", func() {
+	var entries []map[string]interface{}
+
+	BeforeEach(func() {
+		DeferCleanup(func(enabled bool) {
+			configuration.Current.EnablePVCAuditLog = enabled
+		}, configuration.Current.EnablePVCAuditLog)
+		configuration.Current.EnablePVCAuditLog = true
+
+		entries = nil
+	})
+
+	// newCapturingLogger returns a logger decoding the JSON log
+	// entries and collecting them
+	newCapturingLogger := func() logr.Logger {
+		return funcr.NewJSON(func(obj string) {
+			var entry map[string]interface{}
+			Expect(json.Unmarshal([]byte(obj), &entry)).To(Succeed())
+			entries = append(entries, entry)
+		}, funcr.Options{})
+	}
+
+	It("gets the requested size of a PVC", func() {
+		pvc := makePVC("cluster-audit", "1", "1", NewPgDataCalculator(), false)
+		Expect(getRequestedSize(&pvc)).To(BeEmpty())
+
+		pvc.Spec.Resources.Requests = corev1.ResourceList{
+			corev1.ResourceStorage: resource.MustParse("2Gi"),
+		}
+		Expect(getRequestedSize(&pvc)).To(Equal("2Gi"))
+	})
+
+	It("tolerates records without PVCs", func(ctx SpecContext) {
+		Expect(func() {
+			auditPVCMutation(ctx, auditRecord{action: auditActionDelete})
+		}).ToNot(Panic())
+	})
+
+	It("writes the audit records with the pvc_audit logger", func(ctx SpecContext) {
+		oldPVC := makePVC("cluster-audit", "1", "1", NewPgDataCalculator(), false)
+		oldPVC.Namespace = "default"
+		oldPVC.Spec.Resources.Requests = corev1.ResourceList{
+			corev1.ResourceStorage: resource.MustParse("1Gi"),
+		}
+		newPVC := oldPVC.DeepCopy()
+		newPVC.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("2Gi")
+
+		auditPVCMutation(logr.NewContext(ctx, newCapturingLogger()), auditRecord{
+			action:      auditActionResize,
+			initiator:   "reconcilePVCQuantity",
+			clusterName: "cluster-audit",
+			oldPVC:      &oldPVC,
+			newPVC:      newPVC,
+			inputs:      map[string]string{"size": "2Gi"},
+		})
+
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0]).To(HaveKeyWithValue("logger", auditLoggerName))
+		Expect(entries[0]).To(HaveKeyWithValue("msg", "PVC mutation"))
+		Expect(entries[0]).To(HaveKeyWithValue("action", string(auditActionResize)))
+		Expect(entries[0]).To(HaveKeyWithValue("initiator", "reconcilePVCQuantity"))
+		Expect(entries[0]).To(HaveKeyWithValue("cluster", "cluster-audit"))
+		Expect(entries[0]).To(HaveKeyWithValue("namespace", "default"))
+		Expect(entries[0]).To(HaveKeyWithValue("pvcName", oldPVC.Name))
+		Expect(entries[0]).To(HaveKeyWithValue("oldSize", "1Gi"))
+		Expect(entries[0]).To(HaveKeyWithValue("newSize", "2Gi"))
+		Expect(entries[0]).To(HaveKey("decisionInputsHash"))
+	})
+
+	It("omits the size of the deleted PVCs when unknown", func(ctx SpecContext) {
+		pvc := makePVC("cluster-audit", "1", "1", NewPgDataCalculator(), false)
+
+		AuditDeletion(logr.NewContext(ctx, newCapturingLogger()), "EnsureInstancePVCGroupIsDeleted", &pvc)
+
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0]).To(HaveKeyWithValue("action", string(auditActionDelete)))
+		Expect(entries[0]).ToNot(HaveKey("oldSize"))
+		Expect(entries[0]).ToNot(HaveKey("newSize"))
+	})
+
+	It("doesn't write audit records when disabled", func(ctx SpecContext) {
+		configuration.Current.EnablePVCAuditLog = false
+		pvc := makePVC("cluster-audit", "1", "1", NewPgDataCalculator(), false)
+
+		AuditDeletion(logr.NewContext(ctx, newCapturingLogger()), "EnsureInstancePVCGroupIsDeleted", &pvc)
+
+		Expect(entries).To(BeEmpty())
+	})
+})
@@ -23,7 +23,6 @@ import (
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
 
 	apiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
 	"github.com/cloudnative-pg/cloudnative-pg/pkg/resources"
@@ -70,12 +69,12 @@ func Build(
 
 	if configuration.Storage.Size != "" {
 		// Insert the storage requirement
-		parsedSize, err := resource.ParseQuantity(configuration.Storage.Size)
-		if err != nil {
+		parsedSize := configuration.Storage.GetSizeOrNil()
+		if parsedSize == nil {
 			return nil, ErrorInvalidSize
 		}
 		builder = builder.WithRequests(corev1.ResourceList{
-			"storage": parsedSize,
+			"storage": *parsedSize,
 		})
 	}
 
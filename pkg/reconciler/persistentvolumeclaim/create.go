@@ -57,7 +57,10 @@ func createIfNotExists(
 		)
 	}
 
-	if err = c.Create(ctx, pvc); err != nil && !apierrs.IsAlreadyExists(err) {
+	if err = c.Create(ctx, pvc); err != nil {
+		if apierrs.IsAlreadyExists(err) {
+			return nil
+		}
 		return fmt.Errorf("unable to create a PVC: %s for this node (nodeSerial: %d): %w",
 			pvc.Name,
 			configuration.NodeSerial,
@@ -65,5 +68,13 @@ func createIfNotExists(
 		)
 	}
 
+	auditPVCMutation(ctx, auditRecord{
+		action:      auditActionCreate,
+		initiator:   "createIfNotExists",
+		clusterName: cluster.Name,
+		newPVC:      pvc,
+		inputs:      configuration.Storage,
+	})
+
 	return nil
 }
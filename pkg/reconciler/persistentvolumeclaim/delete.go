@@ -59,7 +59,15 @@ func EnsureInstancePVCGroupIsDeleted(
 			if !apierrs.IsNotFound(err) {
 				return fmt.Errorf("scaling down node (%s pvc) %v: %w", expectedPVC.name, name, err)
 			}
+			continue
 		}
+
+		auditPVCMutation(ctx, auditRecord{
+			action:      auditActionDelete,
+			initiator:   "EnsureInstancePVCGroupIsDeleted",
+			clusterName: cluster.Name,
+			oldPVC:      &pvc,
+		})
 	}
 
 	return nil
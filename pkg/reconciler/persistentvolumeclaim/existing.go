@@ -22,12 +22,18 @@ package persistentvolumeclaim
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/cloudnative-pg/machinery/pkg/log"
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+	"github.com/cloudnative-pg/cloudnative-pg/internal/configuration"
 	"github.com/cloudnative-pg/cloudnative-pg/pkg/resources"
 	"github.com/cloudnative-pg/cloudnative-pg/pkg/utils"
 )
@@ -39,42 +45,69 @@ type reconciliationUnit func(
 	pvc *corev1.PersistentVolumeClaim,
 ) error
 
-// reconcileExistingPVCs align the existing pvcs to the desired state
+// reconcileExistingPVCs align the existing pvcs to the desired state.
+// A non-zero result means that some changes have been deferred
 func reconcileExistingPVCs(
 	ctx context.Context,
 	c client.Client,
+	recorder record.EventRecorder,
 	cluster *apiv1.Cluster,
 	pvcs []corev1.PersistentVolumeClaim,
-) error {
+) (ctrl.Result, error) {
 	if len(pvcs) == 0 {
-		return nil
+		return ctrl.Result{}, nil
 	}
 
 	contextLogger := log.FromContext(ctx)
 
-	var reconciliationUnits []reconciliationUnit
+	// When the size of the PVCs is managed by an external resizer
+	// we never change it, otherwise we would be fighting with it
+	resizeInUseVolumes := cluster.ShouldResizeInUseVolumes() &&
+		!utils.IsExternalStorageResizerEnabled(&cluster.ObjectMeta)
 
-	if cluster.ShouldResizeInUseVolumes() {
-		reconciliationUnits = append(reconciliationUnits, reconcilePVCQuantity)
-	}
+	var reconciliationUnits []reconciliationUnit
 	if cluster.Spec.StorageConfiguration.PersistentVolumeClaimTemplate != nil {
 		reconciliationUnits = append(reconciliationUnits, reconcileVolumeAttributeClass)
 	}
 
-	if len(reconciliationUnits) == 0 {
-		return nil
+	if !resizeInUseVolumes && len(reconciliationUnits) == 0 {
+		return ctrl.Result{}, nil
 	}
 
+	// Expanding a volume while a snapshot of it is being taken fails
+	// on several CSI drivers, so we don't resize the PVCs of the
+	// instances that are the target of a running volume snapshot backup
+	snapshotTargets := &volumeSnapshotTargets{cluster: cluster}
+
+	notices := reportedResizeNotices.begin(cluster)
+	defer notices.commit()
+
+	var result ctrl.Result
+
 	for idx := range pvcs {
 		pvc := &pvcs[idx]
 
+		// PVCs pending deletion are being replaced, e.g. when an
+		// instance is being rebuilt, and patching them would fail
+		if pvc.DeletionTimestamp != nil {
+			contextLogger.Debug("Skipping reconciliation of PVC pending deletion", "pvcName", pvc.Name)
+			continue
+		}
+
+		// PVCs that are part of an incomplete group can't be used by
+		// any instance and are going to be replaced
+		if slices.Contains(cluster.Status.UnusablePVC, pvc.Name) {
+			contextLogger.Debug("Skipping reconciliation of unusable PVC", "pvcName", pvc.Name)
+			continue
+		}
+
 		pvcRole, err := GetExpectedObjectCalculator(pvc.GetLabels())
 		if err != nil {
 			contextLogger.Error(err,
 				"encountered an error while trying to get pvc role from label",
 				"role", pvc.Labels[utils.PvcRoleLabelName],
 			)
-			return err
+			return ctrl.Result{}, err
 		}
 
 		storageConfiguration, err := pvcRole.GetStorageConfiguration(cluster)
@@ -84,17 +117,239 @@ func reconcileExistingPVCs(
 				"role", pvc.Labels[utils.PvcRoleLabelName],
 				"pvcName", pvc.Name,
 			)
-			return err
+			return ctrl.Result{}, err
+		}
+
+		if resizeInUseVolumes {
+			deferred, err := reconcilePVCSize(
+				ctx, c, recorder, cluster, &storageConfiguration, pvc, snapshotTargets, notices)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if deferred {
+				result = ctrl.Result{RequeueAfter: 30 * time.Second}
+			}
 		}
 
 		for _, reconciler := range reconciliationUnits {
 			if err := reconciler(ctx, c, &storageConfiguration, pvc); err != nil {
-				return err
+				return ctrl.Result{}, err
 			}
 		}
 	}
 
-	return nil
+	return result, nil
+}
+
+// reconcilePVCSize resizes the PVC to the size requested in the storage
+// configuration, unless the resize needs to be skipped or deferred.
+// It returns true when the resize has been deferred and should be retried
+func reconcilePVCSize(
+	ctx context.Context,
+	c client.Client,
+	recorder record.EventRecorder,
+	cluster *apiv1.Cluster,
+	storageConfiguration *apiv1.StorageConfiguration,
+	pvc *corev1.PersistentVolumeClaim,
+	snapshotTargets *volumeSnapshotTargets,
+	notices *resizeNotices,
+) (bool, error) {
+	contextLogger := log.FromContext(ctx).WithValues("pvcName", pvc.Name)
+
+	switch {
+	case !storageConfiguration.ShouldResizeInUseVolumes():
+		if !needsResize(storageConfiguration, pvc) {
+			contextLogger.Trace("Skipping resize of PVC excluded by its storage configuration",
+				"role", pvc.Labels[utils.PvcRoleLabelName],
+			)
+			return false, nil
+		}
+
+		// The PVC is smaller than requested, and the user needs to know
+		// that the operator is not going to resize it
+		message := fmt.Sprintf("Not resizing PVC %s to %s, resizeInUseVolumes is disabled for its volume",
+			pvc.Name, storageConfiguration.GetSizeOrNil().String())
+		if notices.report(pvc.Name, message) {
+			contextLogger.Info("Not resizing PVC excluded by its storage configuration",
+				"role", pvc.Labels[utils.PvcRoleLabelName],
+			)
+			recorder.Event(cluster, "Normal", "ResizeExcluded", message)
+		}
+		return false, nil
+
+	case utils.IsExternalStorageResizerEnabled(&pvc.ObjectMeta):
+		if !needsResize(storageConfiguration, pvc) {
+			contextLogger.Trace("Skipping resize of PVC managed by an external resizer")
+			return false, nil
+		}
+
+		// The PVC is smaller than requested, and the user needs to know
+		// that the operator is not going to resize it
+		message := fmt.Sprintf("Not resizing PVC %s to %s, its size is managed by an external resizer",
+			pvc.Name, storageConfiguration.GetSizeOrNil().String())
+		if notices.report(pvc.Name, message) {
+			contextLogger.Info("Not resizing PVC managed by an external resizer")
+			recorder.Event(cluster, "Normal", "ResizeManagedExternally", message)
+		}
+		return false, nil
+
+	case !needsResize(storageConfiguration, pvc):
+		// The operator never shrinks a PVC, and keeps the size of the
+		// PVCs that have been enlarged outside of it
+		adoptExternalSize(ctx, recorder, cluster, storageConfiguration, pvc, notices)
+		return false, reconcilePVCQuantity(ctx, c, storageConfiguration, pvc)
+
+	case pvc.Status.Phase != corev1.ClaimBound:
+		// Only bound PVCs can be expanded. PVCs using a storage class
+		// with the WaitForFirstConsumer binding mode stay unbound until
+		// their Pod is scheduled, and we'll resize them once bound
+		message := fmt.Sprintf("Waiting for PVC %s to be bound before resizing it", pvc.Name)
+		if notices.report(pvc.Name, message) {
+			contextLogger.Info("Waiting for the PVC to be bound before resizing it",
+				"phase", pvc.Status.Phase,
+			)
+			recorder.Event(cluster, "Normal", "WaitingForBinding", message)
+		}
+		return false, nil
+	}
+
+	instanceName := pvc.Labels[utils.InstanceNameLabelName]
+	isSnapshotTarget, err := snapshotTargets.contains(ctx, c, instanceName)
+	if err != nil {
+		return false, err
+	}
+	if isSnapshotTarget {
+		contextLogger.Info("Deferring PVC resize while a volume snapshot backup is running",
+			"instanceName", instanceName,
+		)
+		recorder.Eventf(cluster, "Normal", "ResizeDeferred",
+			"Deferring the resize of PVC %s while a volume snapshot backup of instance %s is running",
+			pvc.Name, instanceName)
+		return true, nil
+	}
+
+	limit := configuration.Current.MaxConcurrentPVCExpansions
+	acquired, err := fleetExpansionLimiter.tryAcquire(ctx, c, limit, pvc)
+	if err != nil {
+		return false, err
+	}
+	if !acquired {
+		contextLogger.Info("Queuing PVC resize behind the maximum number of concurrent PVC expansions",
+			"maxConcurrentPVCExpansions", limit,
+		)
+		recorder.Eventf(cluster, "Normal", "QueuedBehindFleetLimit",
+			"Queuing the resize of PVC %s, the maximum number of %d concurrent PVC expansions has been reached",
+			pvc.Name, limit)
+		return true, nil
+	}
+
+	if err := reconcilePVCQuantity(ctx, c, storageConfiguration, pvc); err != nil {
+		if apierrs.IsConflict(err) {
+			// The PVC has been changed since it was listed, e.g. by a user
+			// enlarging it manually, and we must never shrink it back.
+			// We'll evaluate the resize again on the updated PVC without
+			// stopping the reconciliation of the other PVCs
+			contextLogger.Info("Deferring PVC resize, the PVC has been changed since it was listed")
+			return true, nil
+		}
+		return false, err
+	}
+
+	return false, nil
+}
+
+// adoptExternalSize reports that the size of a PVC has been changed
+// outside the operator to a value larger than the requested one,
+// which is kept as the effective size
+func adoptExternalSize(
+	ctx context.Context,
+	recorder record.EventRecorder,
+	cluster *apiv1.Cluster,
+	storageConfiguration *apiv1.StorageConfiguration,
+	pvc *corev1.PersistentVolumeClaim,
+	notices *resizeNotices,
+) {
+	requestedSize := storageConfiguration.GetSizeOrNil()
+	currentSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if requestedSize == nil || currentSize.Cmp(*requestedSize) <= 0 {
+		return
+	}
+
+	message := fmt.Sprintf("Adopting the size %s of PVC %s, larger than the requested %s",
+		currentSize.String(), pvc.Name, requestedSize.String())
+	if !notices.report(pvc.Name, message) {
+		return
+	}
+
+	log.FromContext(ctx).Info("Adopting the size of the PVC changed outside the operator",
+		"pvcName", pvc.Name,
+		"size", currentSize.String(),
+		"requestedSize", requestedSize.String(),
+	)
+	recorder.Event(cluster, "Normal", "AdoptedExternalSize", message)
+}
+
+// volumeSnapshotTargets are the instances that are the target of a volume
+// snapshot backup in progress. They are listed the first time they are
+// needed, i.e. when a PVC needs to be resized
+type volumeSnapshotTargets struct {
+	cluster *apiv1.Cluster
+	names   []string
+	loaded  bool
+}
+
+// contains checks if the passed instance is the target of a volume
+// snapshot backup in progress
+func (t *volumeSnapshotTargets) contains(ctx context.Context, c client.Client, instanceName string) (bool, error) {
+	if !t.loaded {
+		names, err := getVolumeSnapshotBackupTargets(ctx, c, t.cluster)
+		if err != nil {
+			return false, err
+		}
+		t.names = names
+		t.loaded = true
+	}
+
+	return slices.Contains(t.names, instanceName), nil
+}
+
+// getVolumeSnapshotBackupTargets returns the names of the instances
+// that are the target of a volume snapshot backup in progress
+func getVolumeSnapshotBackupTargets(
+	ctx context.Context,
+	c client.Client,
+	cluster *apiv1.Cluster,
+) ([]string, error) {
+	var backupList apiv1.BackupList
+	if err := c.List(ctx, &backupList, client.InNamespace(cluster.Namespace)); err != nil {
+		return nil, fmt.Errorf("while listing backups: %w", err)
+	}
+
+	var targets []string
+	for idx := range backupList.Items {
+		backup := &backupList.Items[idx]
+		if backup.Spec.Cluster.Name != cluster.Name ||
+			backup.Spec.Method != apiv1.BackupMethodVolumeSnapshot ||
+			!backup.Status.IsInProgress() ||
+			backup.Status.InstanceID == nil {
+			continue
+		}
+		targets = append(targets, backup.Status.InstanceID.PodName)
+	}
+
+	return targets, nil
+}
+
+// needsResize checks if the PVC is smaller than the size requested
+// in the storage configuration
+func needsResize(storageConfiguration *apiv1.StorageConfiguration, pvc *corev1.PersistentVolumeClaim) bool {
+	parsedSize := storageConfiguration.GetSizeOrNil()
+	if parsedSize == nil {
+		return false
+	}
+
+	currentSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	return currentSize.Cmp(*parsedSize) < 0
 }
 
 func reconcileVolumeAttributeClass(
@@ -118,6 +373,14 @@ func reconcileVolumeAttributeClass(
 		return fmt.Errorf("error while changing PVC volume attributes class name: %w", err)
 	}
 
+	auditPVCMutation(ctx, auditRecord{
+		action:    auditActionChangeVolumeAttributesClass,
+		initiator: "reconcileVolumeAttributeClass",
+		oldPVC:    oldPVC,
+		newPVC:    pvc,
+		inputs:    storageConfiguration,
+	})
+
 	return nil
 }
 
@@ -151,7 +414,17 @@ func reconcilePVCQuantity(
 		WithRequests(corev1.ResourceList{"storage": *parsedSize}).
 		Build()
 
-	if err := c.Patch(ctx, pvc, client.MergeFrom(oldPVC)); err != nil {
+	// The size of the PVC may have been changed since we read it, e.g.
+	// by a user enlarging it manually. Using the optimistic lock we get a
+	// conflict instead of overwriting it
+	if err := c.Patch(
+		ctx,
+		pvc,
+		client.MergeFromWithOptions(oldPVC, client.MergeFromWithOptimisticLock{}),
+	); err != nil {
+		if apierrs.IsConflict(err) {
+			return err
+		}
 		contextLogger.Error(err, "error while changing PVC storage requirement",
 			"pvcName", pvc.Name,
 			"pvc", pvc,
@@ -160,5 +433,13 @@ func reconcilePVCQuantity(
 		return fmt.Errorf("error while changing PVC storage requirement: %w", err)
 	}
 
+	auditPVCMutation(ctx, auditRecord{
+		action:    auditActionResize,
+		initiator: "reconcilePVCQuantity",
+		oldPVC:    oldPVC,
+		newPVC:    pvc,
+		inputs:    storageConfiguration,
+	})
+
 	return nil
 }
@@ -0,0 +1,195 @@
+/*
+Copyright © contributors to CloudNativePG, established as
+CloudNativePG a Series of LF Projects, LLC.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package persistentvolumeclaim
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/cloudnative-pg/cloudnative-pg/pkg/utils"
+)
+
+const (
+	// expansionTimeout is the time after which a volume expansion still
+	// running on the storage backend is considered stuck, and is not
+	// counted against the limit of concurrent expansions anymore
+	expansionTimeout = 30 * time.Minute
+
+	// grantTimeout is how long a slot granted by the limiter is held
+	// while waiting for the informer cache to report the expansion
+	grantTimeout = 2 * time.Minute
+
+	// queueTimeout is the time after which a queued expansion that has
+	// not been requested again is dropped from the queue. Deferred
+	// resizes are retried every 30 seconds
+	queueTimeout = 2 * time.Minute
+)
+
+// fleetExpansionLimiter is shared by all the cluster reconciliation loops
+// running in the operator. Given that only the leader reconciles clusters,
+// bounding the expansions in this process bounds them across the fleet
+var fleetExpansionLimiter = newExpansionLimiter()
+
+// expansionLimiter bounds the number of PVC expansions that can be in
+// progress at the same time across all the clusters managed by the operator.
+// Expansions exceeding the limit are queued, and the free slots are granted
+// in the order in which they were first requested
+type expansionLimiter struct {
+	mu sync.Mutex
+
+	// granted maps the PVCs allowed to be expanded to the time the
+	// slot was granted
+	granted map[string]time.Time
+
+	// queued maps the PVCs waiting for a free slot to their position
+	// in the queue
+	queued map[string]*queuedExpansion
+}
+
+// queuedExpansion is a PVC expansion waiting for a free slot
+type queuedExpansion struct {
+	// since is when the expansion has been queued
+	since time.Time
+
+	// lastSeen is the last time the expansion has been requested
+	lastSeen time.Time
+}
+
+func newExpansionLimiter() *expansionLimiter {
+	return &expansionLimiter{
+		granted: make(map[string]time.Time),
+		queued:  make(map[string]*queuedExpansion),
+	}
+}
+
+// tryAcquire checks if the expansion of the passed PVC can be started,
+// granting it a slot if so. When the limit has been reached, or other
+// expansions have been waiting for longer, the PVC is queued and false
+// is returned. A limit of zero or less means no limit
+func (l *expansionLimiter) tryAcquire(
+	ctx context.Context,
+	c client.Client,
+	limit int,
+	pvc *corev1.PersistentVolumeClaim,
+) (bool, error) {
+	if limit <= 0 {
+		return true, nil
+	}
+
+	var pvcList corev1.PersistentVolumeClaimList
+	if err := c.List(ctx, &pvcList, client.HasLabels{utils.ClusterLabelName}); err != nil {
+		return false, fmt.Errorf("while listing PVCs being expanded: %w", err)
+	}
+
+	key := client.ObjectKeyFromObject(pvc).String()
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.expire(now)
+
+	inFlight := make(map[string]struct{}, len(l.granted))
+	for name := range l.granted {
+		inFlight[name] = struct{}{}
+	}
+	for idx := range pvcList.Items {
+		if isExpansionRunning(&pvcList.Items[idx], now) {
+			inFlight[client.ObjectKeyFromObject(&pvcList.Items[idx]).String()] = struct{}{}
+		}
+	}
+
+	// A PVC that is already being expanded is already holding a slot
+	if _, ok := inFlight[key]; ok {
+		delete(l.queued, key)
+		return true, nil
+	}
+
+	entry, ok := l.queued[key]
+	if !ok {
+		entry = &queuedExpansion{since: now}
+		l.queued[key] = entry
+	}
+	entry.lastSeen = now
+
+	available := limit - len(inFlight)
+	if available <= 0 || l.countQueuedBefore(key, entry) >= available {
+		return false, nil
+	}
+
+	delete(l.queued, key)
+	l.granted[key] = now
+	return true, nil
+}
+
+// expire releases the slots granted for longer than grantTimeout, whose
+// expansions are reported by the informer cache by then, and drops the
+// queued expansions that are not being requested anymore
+func (l *expansionLimiter) expire(now time.Time) {
+	for key, grantedAt := range l.granted {
+		if now.Sub(grantedAt) > grantTimeout {
+			delete(l.granted, key)
+		}
+	}
+
+	for key, entry := range l.queued {
+		if now.Sub(entry.lastSeen) > queueTimeout {
+			delete(l.queued, key)
+		}
+	}
+}
+
+// countQueuedBefore counts the queued expansions that have been waiting
+// for longer than the passed one
+func (l *expansionLimiter) countQueuedBefore(key string, entry *queuedExpansion) int {
+	count := 0
+	for otherKey, other := range l.queued {
+		if other.since.Before(entry.since) || (other.since.Equal(entry.since) && otherKey < key) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// isExpansionRunning returns true if the storage backend is expanding the
+// volume of the PVC. Expansions that failed, that only wait for the file
+// system to be resized, or that have been running for longer than
+// expansionTimeout don't load the storage backend and are not counted
+func isExpansionRunning(pvc *corev1.PersistentVolumeClaim, now time.Time) bool {
+	running := false
+	for _, condition := range pvc.Status.Conditions {
+		switch condition.Type {
+		case corev1.PersistentVolumeClaimControllerResizeError:
+			return false
+
+		case corev1.PersistentVolumeClaimResizing:
+			running = condition.LastTransitionTime.IsZero() ||
+				now.Sub(condition.LastTransitionTime.Time) < expansionTimeout
+		}
+	}
+
+	return running
+}
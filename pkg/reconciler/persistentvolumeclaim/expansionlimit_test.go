@@ -0,0 +1,122 @@
+/*
+Copyright © contributors to CloudNativePG, established as
+CloudNativePG a Series of LF Projects, LLC.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package persistentvolumeclaim
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/cloudnative-pg/cloudnative-pg/internal/scheme"
+	"github.com/cloudnative-pg/cloudnative-pg/pkg/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PVC expansion limiter", func() {
+	var (
+		limiter      *expansionLimiter
+		expandingPVC corev1.PersistentVolumeClaim
+		first        corev1.PersistentVolumeClaim
+		second       corev1.PersistentVolumeClaim
+	)
+
+	BeforeEach(func() {
+		limiter = newExpansionLimiter()
+		expandingPVC = makePVC("cluster-expanding", "1", "1", NewPgDataCalculator(), true)
+		expandingPVC.Labels[utils.ClusterLabelName] = "cluster-expanding"
+		first = makePVC("cluster-first", "1", "1", NewPgDataCalculator(), false)
+		second = makePVC("cluster-second", "1", "1", NewPgDataCalculator(), false)
+	})
+
+	newClient := func(objects ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.BuildWithAllKnownScheme()).
+			WithObjects(objects...).
+			Build()
+	}
+
+	It("doesn't limit the expansions when no limit is set", func(ctx SpecContext) {
+		cli := newClient(&expandingPVC)
+
+		Expect(limiter.tryAcquire(ctx, cli, 0, &first)).To(BeTrue())
+		Expect(limiter.tryAcquire(ctx, cli, 0, &second)).To(BeTrue())
+	})
+
+	It("counts the slots granted before the cache reports the expansions", func(ctx SpecContext) {
+		cli := newClient()
+
+		Expect(limiter.tryAcquire(ctx, cli, 1, &first)).To(BeTrue())
+		Expect(limiter.tryAcquire(ctx, cli, 1, &second)).To(BeFalse())
+
+		// Requesting the slot again doesn't need another one
+		Expect(limiter.tryAcquire(ctx, cli, 1, &first)).To(BeTrue())
+	})
+
+	It("grants the free slots in the order they were requested", func(ctx SpecContext) {
+		cli := newClient(&expandingPVC)
+
+		Expect(limiter.tryAcquire(ctx, cli, 1, &first)).To(BeFalse())
+		Expect(limiter.tryAcquire(ctx, cli, 1, &second)).To(BeFalse())
+
+		Expect(cli.Delete(ctx, &expandingPVC)).To(Succeed())
+		Expect(limiter.tryAcquire(ctx, cli, 1, &second)).To(BeFalse())
+		Expect(limiter.tryAcquire(ctx, cli, 1, &first)).To(BeTrue())
+	})
+
+	It("drops the queued expansions that are not requested anymore", func(ctx SpecContext) {
+		cli := newClient()
+
+		limiter.queued[client.ObjectKeyFromObject(&first).String()] = &queuedExpansion{
+			since:    time.Now().Add(-2 * queueTimeout),
+			lastSeen: time.Now().Add(-2 * queueTimeout),
+		}
+		Expect(limiter.tryAcquire(ctx, cli, 1, &second)).To(BeTrue())
+	})
+
+	It("doesn't count the stuck or failed expansions", func(ctx SpecContext) {
+		now := time.Now()
+		Expect(isExpansionRunning(&expandingPVC, now)).To(BeTrue())
+
+		expandingPVC.Status.Conditions[0].LastTransitionTime = metav1.NewTime(now.Add(-2 * expansionTimeout))
+		Expect(isExpansionRunning(&expandingPVC, now)).To(BeFalse())
+
+		expandingPVC.Status.Conditions[0].LastTransitionTime = metav1.NewTime(now)
+		expandingPVC.Status.Conditions = append(expandingPVC.Status.Conditions,
+			corev1.PersistentVolumeClaimCondition{
+				Type:   corev1.PersistentVolumeClaimControllerResizeError,
+				Status: corev1.ConditionTrue,
+			})
+		Expect(isExpansionRunning(&expandingPVC, now)).To(BeFalse())
+
+		pendingPVC := makePVC("cluster-pending", "1", "1", NewPgDataCalculator(), false)
+		pendingPVC.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+			{
+				Type:   corev1.PersistentVolumeClaimFileSystemResizePending,
+				Status: corev1.ConditionTrue,
+			},
+		}
+		Expect(isExpansionRunning(&pendingPVC, now)).To(BeFalse())
+	})
+})
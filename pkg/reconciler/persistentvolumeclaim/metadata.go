@@ -59,13 +59,20 @@ func (m metadataReconciler) reconcile(
 			continue
 		}
 
-		patch := client.MergeFrom(pvc.DeepCopy())
+		oldPVC := pvc.DeepCopy()
 		m.update(pvc)
 
 		contextLogger.Info("Updating pvc metadata", "pvc", pvc.Name, "reconciler", m.name)
-		if err := c.Patch(ctx, pvc, patch); err != nil {
+		if err := c.Patch(ctx, pvc, client.MergeFrom(oldPVC)); err != nil {
 			return err
 		}
+
+		auditPVCMutation(ctx, auditRecord{
+			action:    auditActionUpdateMetadata,
+			initiator: m.name,
+			oldPVC:    oldPVC,
+			newPVC:    pvc,
+		})
 	}
 
 	return nil
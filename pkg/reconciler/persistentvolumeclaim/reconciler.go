@@ -25,34 +25,43 @@ import (
 	"github.com/cloudnative-pg/machinery/pkg/log"
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
 )
 
-// Reconcile reconciles the PVCs
+// Reconcile reconciles the PVCs. A non-zero result means that the
+// reconciliation of the cluster can't proceed and needs to be retried.
+// The changes to the existing PVCs that have been deferred, like the
+// resizes postponed while a volume snapshot backup is running, are
+// reported by the deferred result instead: they must not stop the
+// reconciliation of the cluster, which needs to be retried once
+// completed
 func Reconcile(
 	ctx context.Context,
 	c client.Client,
+	recorder record.EventRecorder,
 	cluster *apiv1.Cluster,
 	instances []corev1.Pod,
 	pvcs []corev1.PersistentVolumeClaim,
-) (ctrl.Result, error) {
+) (result ctrl.Result, deferred ctrl.Result, err error) {
 	contextLogger := log.FromContext(ctx)
 
 	if res, err := reconcileMultipleInstancesMissingPVCs(ctx, c, cluster, instances, pvcs); !res.IsZero() || err != nil {
-		return res, err
+		return res, ctrl.Result{}, err
 	}
 
-	if err := reconcileExistingPVCs(ctx, c, cluster, pvcs); err != nil {
+	deferred, err = reconcileExistingPVCs(ctx, c, recorder, cluster, pvcs)
+	if err != nil {
 		if apierrs.IsConflict(err) {
 			contextLogger.Debug("Conflict error while reconciling PVCs", "error", err)
-			return ctrl.Result{Requeue: true}, nil
+			return ctrl.Result{Requeue: true}, ctrl.Result{}, nil
 		}
 
-		return ctrl.Result{}, err
+		return ctrl.Result{}, ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, deferred, nil
 }
@@ -25,12 +25,15 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	apiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
 	"github.com/cloudnative-pg/cloudnative-pg/internal/configuration"
@@ -165,9 +168,10 @@ var _ = Describe("Reconcile resource requests", func() {
 	cluster := &apiv1.Cluster{}
 
 	It("Reconcile resources with empty PVCs shouldn't fail", func() {
-		err := reconcileExistingPVCs(
+		_, err := reconcileExistingPVCs(
 			context.Background(),
 			cli,
+			record.NewFakeRecorder(10),
 			cluster,
 			[]corev1.PersistentVolumeClaim{},
 		)
@@ -182,9 +186,10 @@ var _ = Describe("Reconcile resource requests", func() {
 		}
 
 		cli := fake.NewClientBuilder().WithScheme(scheme.BuildWithAllKnownScheme()).WithObjects(cluster).Build()
-		err := reconcileExistingPVCs(
+		_, err := reconcileExistingPVCs(
 			context.Background(),
 			cli,
+			record.NewFakeRecorder(10),
 			cluster,
 			[]corev1.PersistentVolumeClaim{},
 		)
@@ -192,6 +197,465 @@ var _ = Describe("Reconcile resource requests", func() {
 	})
 })
 
+// makeResizeTestCluster builds a cluster requesting 2Gi of storage
+func makeResizeTestCluster(clusterName string) *apiv1.Cluster {
+	return &apiv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: apiv1.ClusterSpec{
+			StorageConfiguration: apiv1.StorageConfiguration{
+				Size: "2Gi",
+			},
+		},
+	}
+}
+
+// makeResizeTestPVC builds a bound data PVC requesting 1Gi of storage
+func makeResizeTestPVC(clusterName, suffix string) corev1.PersistentVolumeClaim {
+	pvc := makePVC(clusterName, suffix, suffix, NewPgDataCalculator(), false)
+	pvc.Spec.Resources.Requests = corev1.ResourceList{
+		corev1.ResourceStorage: resource.MustParse("1Gi"),
+	}
+	return pvc
+}
+
+func newResizeTestClient(objects ...client.Object) client.Client {
+	return fake.NewClientBuilder().
+		WithScheme(scheme.BuildWithAllKnownScheme()).
+		WithObjects(objects...).
+		Build()
+}
+
+// getRequestedStorage gets the storage requested by a PVC
+func getRequestedStorage(ctx context.Context, cli client.Client, pvcName string) resource.Quantity {
+	var fetchedPVC corev1.PersistentVolumeClaim
+	Expect(cli.Get(ctx, types.NamespacedName{Name: pvcName}, &fetchedPVC)).To(Succeed())
+	return fetchedPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+}
+
+var _ = Describe("Reconcile resource requests during volume snapshot backups", func() {
+	const clusterName = "cluster-snapshot-resize"
+
+	var (
+		cluster  *apiv1.Cluster
+		pvc      corev1.PersistentVolumeClaim
+		backup   *apiv1.Backup
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		cluster = makeResizeTestCluster(clusterName)
+		pvc = makeResizeTestPVC(clusterName, "1")
+		backup = &apiv1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "snapshot-backup",
+			},
+			Spec: apiv1.BackupSpec{
+				Cluster: apiv1.LocalObjectReference{Name: clusterName},
+				Method:  apiv1.BackupMethodVolumeSnapshot,
+			},
+			Status: apiv1.BackupStatus{
+				Phase:      apiv1.BackupPhaseRunning,
+				InstanceID: &apiv1.InstanceID{PodName: clusterName + "-1"},
+			},
+		}
+	})
+
+	It("defers the resize while the instance is the target of a snapshot backup", func(ctx SpecContext) {
+		cli := newResizeTestClient(cluster, &pvc, backup)
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).ToNot(BeZero())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("1Gi")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("ResizeDeferred")))
+	})
+
+	It("resizes the PVC once the snapshot backup is completed", func(ctx SpecContext) {
+		backup.Status.Phase = apiv1.BackupPhaseCompleted
+		cli := newResizeTestClient(cluster, &pvc, backup)
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("2Gi")))
+	})
+
+	It("doesn't list the backups when no PVC needs to be resized", func(ctx SpecContext) {
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("2Gi")
+		backupLists := 0
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme.BuildWithAllKnownScheme()).
+			WithObjects(cluster, &pvc, backup).
+			WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList,
+					opts ...client.ListOption,
+				) error {
+					if _, ok := list.(*apiv1.BackupList); ok {
+						backupLists++
+					}
+					return cl.List(ctx, list, opts...)
+				},
+			}).
+			Build()
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(backupLists).To(BeZero())
+	})
+})
+
+var _ = Describe("Reconcile the size of PVCs being replaced", func() {
+	const clusterName = "cluster-replaced-pvcs"
+
+	var (
+		cluster  *apiv1.Cluster
+		pvc      corev1.PersistentVolumeClaim
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		cluster = makeResizeTestCluster(clusterName)
+		pvc = makeResizeTestPVC(clusterName, "1")
+	})
+
+	It("ignores the PVCs pending deletion", func(ctx SpecContext) {
+		cli := newResizeTestClient(cluster, &pvc)
+
+		deletingPVC := pvc.DeepCopy()
+		deletingPVC.DeletionTimestamp = ptr.To(metav1.Now())
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{*deletingPVC})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("1Gi")))
+	})
+
+	It("ignores the PVCs that can't be used by any instance", func(ctx SpecContext) {
+		cluster.Status.UnusablePVC = []string{pvc.Name}
+		cli := newResizeTestClient(cluster, &pvc)
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("1Gi")))
+	})
+
+	It("resizes the PVC of the instance replacing the one being deleted", func(ctx SpecContext) {
+		deletingPVC := makeResizeTestPVC(clusterName, "2")
+		deletingPVC.DeletionTimestamp = ptr.To(metav1.Now())
+		cli := newResizeTestClient(cluster, &pvc)
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster,
+			[]corev1.PersistentVolumeClaim{deletingPVC, pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("2Gi")))
+	})
+})
+
+var _ = Describe("Reconcile the size of unbound PVCs", func() {
+	const clusterName = "cluster-unbound-pvcs"
+
+	var (
+		cluster  *apiv1.Cluster
+		pvc      corev1.PersistentVolumeClaim
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		reportedResizeNotices = newResizeNoticeRegistry()
+		DeferCleanup(func() {
+			reportedResizeNotices = newResizeNoticeRegistry()
+		})
+
+		recorder = record.NewFakeRecorder(10)
+		cluster = makeResizeTestCluster(clusterName)
+		pvc = makeResizeTestPVC(clusterName, "1")
+	})
+
+	It("waits for the PVC to be bound before resizing it", func(ctx SpecContext) {
+		pvc.Status.Phase = corev1.ClaimPending
+		cli := newResizeTestClient(cluster, &pvc)
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("1Gi")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("WaitingForBinding")))
+	})
+
+	It("reports the wait for the binding only when it starts", func(ctx SpecContext) {
+		pvc.Status.Phase = corev1.ClaimPending
+		cli := newResizeTestClient(cluster, &pvc)
+
+		_, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).To(Receive(ContainSubstring("WaitingForBinding")))
+
+		_, err = reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).ToNot(Receive())
+
+		By("reporting it again when the PVC is waiting for a new binding")
+		boundPVC := pvc.DeepCopy()
+		boundPVC.Status.Phase = corev1.ClaimBound
+		_, err = reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{*boundPVC})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).To(Receive(ContainSubstring("WaitingForBinding")))
+	})
+})
+
+var _ = Describe("Reconcile the size of PVCs managed by an external resizer", func() {
+	const clusterName = "cluster-external-resizer"
+
+	var (
+		cluster  *apiv1.Cluster
+		pvc      corev1.PersistentVolumeClaim
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		reportedResizeNotices = newResizeNoticeRegistry()
+		DeferCleanup(func() {
+			reportedResizeNotices = newResizeNoticeRegistry()
+		})
+
+		recorder = record.NewFakeRecorder(10)
+		cluster = makeResizeTestCluster(clusterName)
+		pvc = makeResizeTestPVC(clusterName, "1")
+	})
+
+	It("doesn't resize the PVCs when the cluster uses an external resizer", func(ctx SpecContext) {
+		cluster.Annotations = map[string]string{
+			utils.ExternalStorageResizerAnnotationName: "true",
+		}
+		cli := newResizeTestClient(cluster, &pvc)
+
+		_, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("1Gi")))
+	})
+
+	It("doesn't resize the PVCs managed by an external resizer", func(ctx SpecContext) {
+		pvc.Annotations[utils.ExternalStorageResizerAnnotationName] = "true"
+		cli := newResizeTestClient(cluster, &pvc)
+
+		_, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("1Gi")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("ResizeManagedExternally")))
+
+		_, err = reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("doesn't report the PVCs managed by an external resizer that are large enough", func(ctx SpecContext) {
+		pvc.Annotations[utils.ExternalStorageResizerAnnotationName] = "true"
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("2Gi")
+		cli := newResizeTestClient(cluster, &pvc)
+
+		_, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).ToNot(Receive())
+	})
+})
+
+var _ = Describe("Reconcile the size of excluded tablespace PVCs", func() {
+	const clusterName = "cluster-excluded-tablespace"
+
+	var (
+		cluster  *apiv1.Cluster
+		pvc      corev1.PersistentVolumeClaim
+		tbsPVC   corev1.PersistentVolumeClaim
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		reportedResizeNotices = newResizeNoticeRegistry()
+		DeferCleanup(func() {
+			reportedResizeNotices = newResizeNoticeRegistry()
+		})
+
+		recorder = record.NewFakeRecorder(10)
+		cluster = makeResizeTestCluster(clusterName)
+		cluster.Spec.Tablespaces = []apiv1.TablespaceConfiguration{
+			{
+				Name: "tbs1",
+				Storage: apiv1.StorageConfiguration{
+					Size:               "2Gi",
+					ResizeInUseVolumes: ptr.To(false),
+				},
+			},
+		}
+		pvc = makeResizeTestPVC(clusterName, "1")
+		tbsPVC = makePVC(clusterName, "1-tbs1", "1", NewPgTablespaceCalculator("tbs1"), false)
+		tbsPVC.Spec.Resources.Requests = corev1.ResourceList{
+			corev1.ResourceStorage: resource.MustParse("1Gi"),
+		}
+	})
+
+	It("doesn't resize the tablespace PVCs excluded by their storage configuration", func(ctx SpecContext) {
+		cli := newResizeTestClient(cluster, &pvc, &tbsPVC)
+
+		_, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc, tbsPVC})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("2Gi")))
+		Expect(getRequestedStorage(ctx, cli, tbsPVC.Name)).To(BeComparableTo(resource.MustParse("1Gi")))
+	})
+
+	It("reports once the excluded tablespace PVCs smaller than requested", func(ctx SpecContext) {
+		cli := newResizeTestClient(cluster, &tbsPVC)
+
+		_, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{tbsPVC})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).To(Receive(SatisfyAll(
+			ContainSubstring("ResizeExcluded"),
+			ContainSubstring(tbsPVC.Name),
+		)))
+
+		_, err = reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{tbsPVC})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).ToNot(Receive())
+	})
+})
+
+var _ = Describe("Reconcile the size of PVCs changed concurrently", func() {
+	const clusterName = "cluster-concurrent-changes"
+
+	var (
+		cluster  *apiv1.Cluster
+		pvc      corev1.PersistentVolumeClaim
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		reportedResizeNotices = newResizeNoticeRegistry()
+		DeferCleanup(func() {
+			reportedResizeNotices = newResizeNoticeRegistry()
+		})
+
+		recorder = record.NewFakeRecorder(10)
+		cluster = makeResizeTestCluster(clusterName)
+		pvc = makeResizeTestPVC(clusterName, "1")
+	})
+
+	It("adopts the size of a PVC already larger than requested", func(ctx SpecContext) {
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("5Gi")
+		cli := newResizeTestClient(cluster, &pvc)
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("5Gi")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("AdoptedExternalSize")))
+
+		_, err = reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("doesn't overwrite a PVC size changed since the PVC was listed", func(ctx SpecContext) {
+		cli := newResizeTestClient(cluster, &pvc)
+
+		userPVC := pvc.DeepCopy()
+		userPVC.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("5Gi")
+		Expect(cli.Update(ctx, userPVC)).To(Succeed())
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).ToNot(BeZero())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("5Gi")))
+
+		By("adopting the new size once the updated PVC is listed")
+		res, err = reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{*userPVC})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("5Gi")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("AdoptedExternalSize")))
+	})
+
+	It("defers the resize when the PVC is changed while being resized", func(ctx SpecContext) {
+		otherPVC := makeResizeTestPVC(clusterName, "2")
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme.BuildWithAllKnownScheme()).
+			WithObjects(cluster, &pvc, &otherPVC).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(ctx context.Context, cl client.WithWatch, obj client.Object, patch client.Patch,
+					opts ...client.PatchOption,
+				) error {
+					if obj.GetName() == pvc.Name {
+						return apierrs.NewConflict(corev1.Resource("persistentvolumeclaims"), obj.GetName(),
+							fmt.Errorf("simulated concurrent change"))
+					}
+					return cl.Patch(ctx, obj, patch, opts...)
+				},
+			}).
+			Build()
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster,
+			[]corev1.PersistentVolumeClaim{pvc, otherPVC})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).ToNot(BeZero())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("1Gi")))
+		Expect(getRequestedStorage(ctx, cli, otherPVC.Name)).To(BeComparableTo(resource.MustParse("2Gi")))
+	})
+})
+
+var _ = Describe("Reconcile the size of PVCs with a limit of concurrent expansions", func() {
+	const clusterName = "cluster-expansion-limit"
+
+	var (
+		cluster      *apiv1.Cluster
+		pvc          corev1.PersistentVolumeClaim
+		expandingPVC corev1.PersistentVolumeClaim
+		recorder     *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		oldConfiguration := *configuration.Current
+		fleetExpansionLimiter = newExpansionLimiter()
+		DeferCleanup(func() {
+			*configuration.Current = oldConfiguration
+			fleetExpansionLimiter = newExpansionLimiter()
+		})
+
+		recorder = record.NewFakeRecorder(10)
+		cluster = makeResizeTestCluster(clusterName)
+		pvc = makeResizeTestPVC(clusterName, "1")
+		expandingPVC = makePVC("another-cluster", "1", "1", NewPgDataCalculator(), true)
+		expandingPVC.Labels[utils.ClusterLabelName] = "another-cluster"
+	})
+
+	It("defers the resize when too many PVCs are being expanded", func(ctx SpecContext) {
+		configuration.Current.MaxConcurrentPVCExpansions = 1
+		cli := newResizeTestClient(cluster, &pvc, &expandingPVC)
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).ToNot(BeZero())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("1Gi")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("QueuedBehindFleetLimit")))
+	})
+
+	It("resizes the PVC when the number of PVCs being expanded is below the limit", func(ctx SpecContext) {
+		configuration.Current.MaxConcurrentPVCExpansions = 2
+		cli := newResizeTestClient(cluster, &pvc, &expandingPVC)
+
+		res, err := reconcileExistingPVCs(ctx, cli, recorder, cluster, []corev1.PersistentVolumeClaim{pvc})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(getRequestedStorage(ctx, cli, pvc.Name)).To(BeComparableTo(resource.MustParse("2Gi")))
+	})
+})
+
 var _ = Describe("PVC reconciliation", Ordered, func() {
 	const clusterName = "cluster-pvc-reconciliation"
 
@@ -0,0 +1,95 @@
+/*
+Copyright © contributors to CloudNativePG, established as
+CloudNativePG a Series of LF Projects, LLC.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package persistentvolumeclaim
+
+import (
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+)
+
+// reportedResizeNotices are the notices about the PVC resizes reported
+// by all the cluster reconciliation loops running in the operator
+var reportedResizeNotices = newResizeNoticeRegistry()
+
+// resizeNoticeRegistry keeps, for each cluster, the last notice reported
+// about the resize of each of its PVCs, e.g. that a PVC is waiting to be
+// bound before being resized. The conditions behind a notice persist across
+// many reconciliation passes, and the notice is reported only when it changes
+type resizeNoticeRegistry struct {
+	mu sync.Mutex
+
+	// notices maps each cluster to the notices reported for its PVCs,
+	// indexed by PVC name
+	notices map[string]map[string]string
+}
+
+func newResizeNoticeRegistry() *resizeNoticeRegistry {
+	return &resizeNoticeRegistry{
+		notices: make(map[string]map[string]string),
+	}
+}
+
+// begin starts tracking the notices of a reconciliation pass of the cluster
+func (r *resizeNoticeRegistry) begin(cluster *apiv1.Cluster) *resizeNotices {
+	key := client.ObjectKeyFromObject(cluster).String()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &resizeNotices{
+		registry: r,
+		key:      key,
+		previous: r.notices[key],
+		current:  make(map[string]string),
+	}
+}
+
+// resizeNotices are the notices about the PVC resizes of a reconciliation
+// pass of a cluster
+type resizeNotices struct {
+	registry *resizeNoticeRegistry
+	key      string
+	previous map[string]string
+	current  map[string]string
+}
+
+// report records the notice about the resize of the passed PVC, returning
+// true if it is different from the one reported in the previous pass and
+// needs to be reported again
+func (n *resizeNotices) report(pvcName, notice string) bool {
+	n.current[pvcName] = notice
+	return n.previous[pvcName] != notice
+}
+
+// commit stores the notices of this pass. The PVCs with no notice in this
+// pass are forgotten, so their next notice will be reported again
+func (n *resizeNotices) commit() {
+	n.registry.mu.Lock()
+	defer n.registry.mu.Unlock()
+
+	if len(n.current) == 0 {
+		delete(n.registry.notices, n.key)
+		return
+	}
+	n.registry.notices[n.key] = n.current
+}
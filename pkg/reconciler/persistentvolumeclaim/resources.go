@@ -76,6 +76,25 @@ func isResizing(pvc corev1.PersistentVolumeClaim) bool {
 	return false
 }
 
+// IsExpansionInProgress returns true if the PVC is being expanded, either
+// because the volume is being resized by the storage backend, or because
+// the file system on it still needs to be resized
+func IsExpansionInProgress(pvc corev1.PersistentVolumeClaim) bool {
+	if isResizing(pvc) {
+		return true
+	}
+
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Type == corev1.PersistentVolumeClaimFileSystemResizePending {
+			return true
+		}
+	}
+
+	capacity, hasCapacity := pvc.Status.Capacity[corev1.ResourceStorage]
+	request, hasRequest := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	return hasCapacity && hasRequest && capacity.Cmp(request) < 0
+}
+
 // BelongToInstance returns a boolean indicating if that given PVC belongs to an instance
 func BelongToInstance(cluster *apiv1.Cluster, instanceName, pvcName string) bool {
 	expectedPVCs := getExpectedInstancePVCNamesFromCluster(cluster, instanceName)
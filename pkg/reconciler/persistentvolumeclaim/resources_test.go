@@ -22,6 +22,7 @@ package persistentvolumeclaim
 import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	apiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
@@ -151,3 +152,33 @@ var _ = Describe("instance with tablespace test", func() {
 		}
 	})
 })
+
+var _ = Describe("PVC expansion detection", func() {
+	const clusterName = "cluster-expansion"
+
+	It("detects the resizing condition", func() {
+		pvc := makePVC(clusterName, "1", "1", NewPgDataCalculator(), true)
+		Expect(IsExpansionInProgress(pvc)).To(BeTrue())
+	})
+
+	It("detects a pending file system resize", func() {
+		pvc := makePVC(clusterName, "1", "1", NewPgDataCalculator(), false)
+		pvc.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+			{
+				Type:   corev1.PersistentVolumeClaimFileSystemResizePending,
+				Status: corev1.ConditionTrue,
+			},
+		}
+		Expect(IsExpansionInProgress(pvc)).To(BeTrue())
+	})
+
+	It("detects a request bigger than the current capacity", func() {
+		pvc := makePVC(clusterName, "1", "1", NewPgDataCalculator(), false)
+		pvc.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")}
+		pvc.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")}
+		Expect(IsExpansionInProgress(pvc)).To(BeTrue())
+
+		pvc.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")}
+		Expect(IsExpansionInProgress(pvc)).To(BeFalse())
+	})
+})
@@ -360,5 +360,16 @@ func setPVCStatusReady(
 	}
 	pvc.Annotations[utils.PVCStatusAnnotationName] = StatusReady
 
-	return cli.Patch(ctx, pvc, client.MergeFrom(oldPvc))
+	if err := cli.Patch(ctx, pvc, client.MergeFrom(oldPvc)); err != nil {
+		return err
+	}
+
+	auditPVCMutation(ctx, auditRecord{
+		action:    auditActionUpdateStatus,
+		initiator: "setPVCStatusReady",
+		oldPVC:    oldPvc,
+		newPVC:    pvc,
+	})
+
+	return nil
 }
@@ -318,6 +318,12 @@ const (
 	// operator if a instance is recoverable or not. Not recoverable instances will
 	// be deleted with the contents of their PVCs.
 	UnrecoverableInstanceAnnotationName = AlphaMetadataNamespace + "/unrecoverable"
+
+	// ExternalStorageResizerAnnotationName is the name of the annotation telling
+	// the operator that the size of the PVCs is managed by an external resizer.
+	// When set to "true" on a Cluster or on a PVC, the operator never changes
+	// the size of the existing PVCs it applies to
+	ExternalStorageResizerAnnotationName = MetadataNamespace + "/externalStorageResizer"
 )
 
 type annotationStatus string
@@ -523,6 +529,12 @@ func IsPodSpecReconciliationDisabled(object *metav1.ObjectMeta) bool {
 	return object.Annotations[ReconcilePodSpecAnnotationName] == string(annotationStatusDisabled)
 }
 
+// IsExternalStorageResizerEnabled checks if the size of the PVCs is
+// managed by an external resizer
+func IsExternalStorageResizerEnabled(object *metav1.ObjectMeta) bool {
+	return object.Annotations[ExternalStorageResizerAnnotationName] == "true"
+}
+
 // IsEmptyWalArchiveCheckEnabled returns a boolean indicating if we should run the logic that checks if the WAL archive
 // storage is empty
 func IsEmptyWalArchiveCheckEnabled(object *metav1.ObjectMeta) bool {